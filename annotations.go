@@ -0,0 +1,340 @@
+package gomoji
+
+// annotation holds the CLDR short name and keywords for an emoji. Shortcode is
+// only set for localized annotations; English uses the mapping's shortcode.
+type annotation struct {
+	Name      string
+	Shortcode string
+	Keywords  []string
+}
+
+// English CLDR annotations (short names and keywords), keyed by emoji name
+var emojiAnnotations = map[string]annotation{
+	// === HAPPY FACE EMOJIS ===
+	"grinning":      {Name: "grinning face", Keywords: []string{"face", "grin", "grinning face", "happy", "smile"}},
+	"grinning_eyes": {Name: "beaming face with smiling eyes", Keywords: []string{"beaming face with smiling eyes", "eye", "face", "grin", "happy", "smile"}},
+	"joy":           {Name: "face with tears of joy", Keywords: []string{"face", "face with tears of joy", "joy", "laugh", "tear"}},
+	"smiley":        {Name: "grinning face with big eyes", Keywords: []string{"face", "grinning face with big eyes", "happy", "mouth", "open", "smile"}},
+	"smile":         {Name: "grinning face with smiling eyes", Keywords: []string{"eye", "face", "grinning face with smiling eyes", "happy", "mouth", "open", "smile"}},
+	"sweat_smile":   {Name: "grinning face with sweat", Keywords: []string{"cold", "face", "grinning face with sweat", "open", "smile", "sweat"}},
+	"laughing":      {Name: "grinning squinting face", Keywords: []string{"face", "grinning squinting face", "happy", "laugh", "mouth", "satisfied", "smile"}},
+	"wink":          {Name: "winking face", Keywords: []string{"face", "wink", "winking face"}},
+	"blush":         {Name: "smiling face with smiling eyes", Keywords: []string{"blush", "eye", "face", "happy", "smile", "smiling face with smiling eyes"}},
+	"yum":           {Name: "face savoring food", Keywords: []string{"delicious", "face", "face savoring food", "savouring", "smile", "yum"}},
+
+	// === NEUTRAL/COOL FACE EMOJIS ===
+	"sunglasses":           {Name: "smiling face with sunglasses", Keywords: []string{"bright", "cool", "face", "smiling face with sunglasses", "sun", "sunglasses"}},
+	"heart_eyes":           {Name: "smiling face with heart-eyes", Keywords: []string{"eye", "face", "love", "smile", "smiling face with heart-eyes"}},
+	"kissing_heart":        {Name: "face blowing a kiss", Keywords: []string{"face", "face blowing a kiss", "kiss"}},
+	"kissing":              {Name: "kissing face", Keywords: []string{"face", "kiss", "kissing face"}},
+	"kissing_smiling_eyes": {Name: "kissing face with smiling eyes", Keywords: []string{"eye", "face", "kiss", "kissing face with smiling eyes", "smile"}},
+	"kissing_closed_eyes":  {Name: "kissing face with closed eyes", Keywords: []string{"closed", "eye", "face", "kiss", "kissing face with closed eyes"}},
+	"relaxed":              {Name: "smiling face", Keywords: []string{"face", "outlined", "relaxed", "smile", "smiling face"}},
+	"slight_smile":         {Name: "slightly smiling face", Keywords: []string{"face", "happy", "slightly smiling face", "smile"}},
+	"upside_down":          {Name: "upside-down face", Keywords: []string{"face", "upside-down", "upside-down face"}},
+
+	// === THINKING/NEUTRAL FACE EMOJIS ===
+	"thinking":       {Name: "thinking face", Keywords: []string{"face", "thinking"}},
+	"neutral_face":   {Name: "neutral face", Keywords: []string{"deadpan", "face", "meh", "neutral"}},
+	"expressionless": {Name: "expressionless face", Keywords: []string{"expressionless", "face", "inexpressive", "meh", "unexpressive"}},
+	"no_mouth":       {Name: "face without mouth", Keywords: []string{"face", "face without mouth", "mouth", "quiet", "silent"}},
+
+	// === SAD/CONCERNED FACE EMOJIS ===
+	"confused":          {Name: "confused face", Keywords: []string{"confused", "face", "meh"}},
+	"worried":           {Name: "worried face", Keywords: []string{"face", "worried"}},
+	"slightly_frowning": {Name: "slightly frowning face", Keywords: []string{"face", "frown", "sad", "slightly frowning face"}},
+	"frowning":          {Name: "frowning face", Keywords: []string{"face", "frown", "frowning face", "sad"}},
+	"persevere":         {Name: "persevering face", Keywords: []string{"face", "persevere", "persevering face"}},
+	"confounded":        {Name: "confounded face", Keywords: []string{"confounded", "face"}},
+	"tired_face":        {Name: "tired face", Keywords: []string{"face", "tired"}},
+	"weary":             {Name: "weary face", Keywords: []string{"face", "tired", "weary"}},
+	"cry":               {Name: "crying face", Keywords: []string{"cry", "crying face", "face", "sad", "tear"}},
+	"sob":               {Name: "loudly crying face", Keywords: []string{"cry", "face", "loudly crying face", "sad", "sob", "tear"}},
+
+	// === ANGRY/UPSET FACE EMOJIS ===
+	"angry":   {Name: "angry face", Keywords: []string{"angry", "face", "mad"}},
+	"rage":    {Name: "enraged face", Keywords: []string{"angry", "enraged", "face", "mad", "pouting", "rage", "red"}},
+	"triumph": {Name: "face with steam from nose", Keywords: []string{"face", "face with steam from nose", "triumph", "won"}},
+
+	// === SURPRISED/SHOCKED FACE EMOJIS ===
+	"open_mouth": {Name: "face with open mouth", Keywords: []string{"face", "face with open mouth", "mouth", "open", "sympathy"}},
+	"scream":     {Name: "face screaming in fear", Keywords: []string{"face", "face screaming in fear", "fear", "munch", "scared", "scream"}},
+	"fearful":    {Name: "fearful face", Keywords: []string{"face", "fear", "fearful", "scared"}},
+	"cold_sweat": {Name: "anxious face with sweat", Keywords: []string{"anxious face with sweat", "blue", "cold", "face", "rushed", "sweat"}},
+	"hushed":     {Name: "hushed face", Keywords: []string{"face", "hushed", "stunned", "surprised"}},
+	"flushed":    {Name: "flushed face", Keywords: []string{"dazed", "face", "flushed"}},
+
+	// === SICK/UNWELL FACE EMOJIS ===
+	"dizzy_face": {Name: "face with crossed-out eyes", Keywords: []string{"crossed-out eyes", "dead", "face", "face with crossed-out eyes", "knocked out"}},
+	"mask":       {Name: "face with medical mask", Keywords: []string{"cold", "doctor", "face", "face with medical mask", "mask", "sick"}},
+
+	// === ANIMALS ===
+	"dog":           {Name: "dog face", Keywords: []string{"dog", "face", "pet"}},
+	"cat":           {Name: "cat face", Keywords: []string{"cat", "face", "pet"}},
+	"mouse":         {Name: "mouse face", Keywords: []string{"face", "mouse"}},
+	"hamster":       {Name: "hamster", Keywords: []string{"face", "hamster", "pet"}},
+	"rabbit":        {Name: "rabbit face", Keywords: []string{"bunny", "face", "pet", "rabbit"}},
+	"bear":          {Name: "bear", Keywords: []string{"bear", "face"}},
+	"panda_face":    {Name: "panda", Keywords: []string{"face", "panda"}},
+	"koala":         {Name: "koala", Keywords: []string{"face", "koala", "marsupial"}},
+	"tiger":         {Name: "tiger face", Keywords: []string{"face", "tiger"}},
+	"lion_face":     {Name: "lion", Keywords: []string{"face", "Leo", "lion", "zodiac"}},
+	"cow":           {Name: "cow face", Keywords: []string{"cow", "face"}},
+	"pig":           {Name: "pig face", Keywords: []string{"face", "pig"}},
+	"pig_nose":      {Name: "pig nose", Keywords: []string{"face", "nose", "pig"}},
+	"frog":          {Name: "frog", Keywords: []string{"face", "frog"}},
+	"octopus":       {Name: "octopus", Keywords: []string{"octopus"}},
+	"monkey_face":   {Name: "monkey face", Keywords: []string{"face", "monkey"}},
+	"see_no_evil":   {Name: "see-no-evil monkey", Keywords: []string{"evil", "face", "forbidden", "monkey", "see", "see-no-evil monkey"}},
+	"hear_no_evil":  {Name: "hear-no-evil monkey", Keywords: []string{"evil", "face", "forbidden", "hear", "hear-no-evil monkey", "monkey"}},
+	"speak_no_evil": {Name: "speak-no-evil monkey", Keywords: []string{"evil", "face", "forbidden", "monkey", "speak", "speak-no-evil monkey"}},
+
+	// === HANDS AND GESTURES ===
+	"thumbs_up":        {Name: "thumbs up", Keywords: []string{"+1", "hand", "thumb", "thumbs up", "up"}},
+	"thumbs_down":      {Name: "thumbs down", Keywords: []string{"-1", "down", "hand", "thumb", "thumbs down"}},
+	"clap":             {Name: "clapping hands", Keywords: []string{"clap", "clapping hands", "hand"}},
+	"raised_hands":     {Name: "raising hands", Keywords: []string{"celebration", "gesture", "hand", "hooray", "raised", "raising hands"}},
+	"open_hands":       {Name: "open hands", Keywords: []string{"hand", "open", "open hands"}},
+	"point_up":         {Name: "index pointing up", Keywords: []string{"finger", "hand", "index", "index pointing up", "point", "up"}},
+	"point_down":       {Name: "backhand index pointing down", Keywords: []string{"backhand", "backhand index pointing down", "down", "finger", "hand", "point"}},
+	"point_left":       {Name: "backhand index pointing left", Keywords: []string{"backhand", "backhand index pointing left", "finger", "hand", "index", "point"}},
+	"point_right":      {Name: "backhand index pointing right", Keywords: []string{"backhand", "backhand index pointing right", "finger", "hand", "index", "point"}},
+	"ok_hand":          {Name: "OK hand", Keywords: []string{"hand", "OK"}},
+	"peace":            {Name: "victory hand", Keywords: []string{"hand", "v", "victory"}},
+	"crossed_fingers":  {Name: "crossed fingers", Keywords: []string{"cross", "crossed fingers", "finger", "hand", "luck"}},
+	"metal":            {Name: "sign of the horns", Keywords: []string{"finger", "hand", "horns", "rock-on", "sign of the horns"}},
+	"call_me":          {Name: "call me hand", Keywords: []string{"call", "call me hand", "hand", "hang loose", "Shaka"}},
+	"fist":             {Name: "raised fist", Keywords: []string{"clenched", "fist", "hand", "punch", "raised fist"}},
+	"punch":            {Name: "oncoming fist", Keywords: []string{"clenched", "fist", "hand", "oncoming fist", "punch"}},
+	"left_fist":        {Name: "left-facing fist", Keywords: []string{"fist", "left-facing fist", "leftwards"}},
+	"right_fist":       {Name: "right-facing fist", Keywords: []string{"fist", "right-facing fist", "rightwards"}},
+	"wave":             {Name: "waving hand", Keywords: []string{"hand", "wave", "waving"}},
+	"pray":             {Name: "folded hands", Keywords: []string{"ask", "folded hands", "hand", "high 5", "high five", "please", "pray", "thanks"}},
+	"raised_hand":      {Name: "raised hand", Keywords: []string{"hand", "high 5", "high five", "raised hand"}},
+	"hand_splayed":     {Name: "hand with fingers splayed", Keywords: []string{"finger", "hand", "hand with fingers splayed", "splayed"}},
+	"vulcan":           {Name: "vulcan salute", Keywords: []string{"finger", "hand", "spock", "vulcan", "vulcan salute"}},
+	"love_you_gesture": {Name: "love-you gesture", Keywords: []string{"hand", "ILY", "love-you gesture"}},
+	"pinching_hand":    {Name: "pinching hand", Keywords: []string{"pinching hand", "small amount"}},
+	"pinched_fingers":  {Name: "pinched fingers", Keywords: []string{"fingers", "hand gesture", "interrogation", "pinched", "sarcastic"}},
+
+	// === PEOPLE ===
+	"woman_teacher":                   {Name: "woman teacher: medium skin tone", Keywords: []string{"instructor", "medium skin tone", "professor", "teacher", "woman"}},
+	"woman_teacher_default_skin_tone": {Name: "woman teacher", Keywords: []string{"instructor", "professor", "teacher", "woman"}},
+	"man_teacher":                     {Name: "man teacher", Keywords: []string{"instructor", "man", "professor", "teacher"}},
+	"man_teacher_medium_skin_tone":    {Name: "man teacher: medium skin tone", Keywords: []string{"instructor", "man", "medium skin tone", "professor", "teacher"}},
+	"teacher":                         {Name: "teacher", Keywords: []string{"instructor", "lecturer", "professor", "teacher"}},
+	"teacher_medium_skin_tone":        {Name: "teacher: medium skin tone", Keywords: []string{"instructor", "lecturer", "medium skin tone", "professor", "teacher"}},
+	"person":                          {Name: "person", Keywords: []string{"adult", "gender-neutral", "person", "unspecified gender"}},
+	"man":                             {Name: "man", Keywords: []string{"adult", "man"}},
+	"woman":                           {Name: "woman", Keywords: []string{"adult", "woman"}},
+	"person_red_hair":                 {Name: "person: red hair", Keywords: []string{"adult", "person", "person: red hair", "red hair"}},
+	"person_curly_hair":               {Name: "person: curly hair", Keywords: []string{"adult", "curly hair", "person", "person: curly hair"}},
+	"person_white_hair":               {Name: "person: white hair", Keywords: []string{"adult", "person", "person: white hair", "white hair"}},
+	"person_bald":                     {Name: "person: bald", Keywords: []string{"adult", "bald", "person", "person: bald"}},
+	"man_red_hair":                    {Name: "man: red hair", Keywords: []string{"adult", "man", "man: red hair", "red hair"}},
+	"man_curly_hair":                  {Name: "man: curly hair", Keywords: []string{"adult", "curly hair", "man", "man: curly hair"}},
+	"man_white_hair":                  {Name: "man: white hair", Keywords: []string{"adult", "man", "man: white hair", "white hair"}},
+	"man_bald":                        {Name: "man: bald", Keywords: []string{"adult", "bald", "man", "man: bald"}},
+	"woman_red_hair":                  {Name: "woman: red hair", Keywords: []string{"adult", "red hair", "woman", "woman: red hair"}},
+	"woman_curly_hair":                {Name: "woman: curly hair", Keywords: []string{"adult", "curly hair", "woman", "woman: curly hair"}},
+	"woman_white_hair":                {Name: "woman: white hair", Keywords: []string{"adult", "white hair", "woman", "woman: white hair"}},
+	"woman_bald":                      {Name: "woman: bald", Keywords: []string{"adult", "bald", "woman", "woman: bald"}},
+	"firefighter":                     {Name: "firefighter", Keywords: []string{"fire", "firefighter", "firetruck"}},
+	"man_firefighter":                 {Name: "man firefighter", Keywords: []string{"fire", "firefighter", "firetruck", "man", "man firefighter"}},
+	"woman_firefighter":               {Name: "woman firefighter", Keywords: []string{"fire", "firefighter", "firetruck", "woman", "woman firefighter"}},
+	"health_worker":                   {Name: "health worker", Keywords: []string{"doctor", "health worker", "healthcare", "nurse", "therapist"}},
+	"man_health_worker":               {Name: "man health worker", Keywords: []string{"doctor", "health worker", "healthcare", "man", "man health worker", "nurse", "therapist"}},
+	"woman_health_worker":             {Name: "woman health worker", Keywords: []string{"doctor", "health worker", "healthcare", "nurse", "therapist", "woman", "woman health worker"}},
+	"cook":                            {Name: "cook", Keywords: []string{"chef", "cook"}},
+	"man_cook":                        {Name: "man cook", Keywords: []string{"chef", "cook", "man", "man cook"}},
+	"woman_cook":                      {Name: "woman cook", Keywords: []string{"chef", "cook", "woman", "woman cook"}},
+	"technologist":                    {Name: "technologist", Keywords: []string{"coder", "developer", "inventor", "software", "technologist"}},
+	"man_technologist":                {Name: "man technologist", Keywords: []string{"coder", "developer", "inventor", "man", "man technologist", "software", "technologist"}},
+	"woman_technologist":              {Name: "woman technologist", Keywords: []string{"coder", "developer", "inventor", "software", "technologist", "woman", "woman technologist"}},
+	"astronaut":                       {Name: "astronaut", Keywords: []string{"astronaut", "rocket"}},
+	"man_astronaut":                   {Name: "man astronaut", Keywords: []string{"astronaut", "man", "man astronaut", "rocket"}},
+	"woman_astronaut":                 {Name: "woman astronaut", Keywords: []string{"astronaut", "rocket", "woman", "woman astronaut"}},
+	"person_shrugging":                {Name: "person shrugging", Keywords: []string{"doubt", "ignorance", "indifference", "person shrugging", "shrug"}},
+	"man_shrugging":                   {Name: "man shrugging", Keywords: []string{"doubt", "ignorance", "indifference", "man", "man shrugging", "shrug"}},
+	"woman_shrugging":                 {Name: "woman shrugging", Keywords: []string{"doubt", "ignorance", "indifference", "shrug", "woman", "woman shrugging"}},
+	"person_facepalming":              {Name: "person facepalming", Keywords: []string{"disbelief", "exasperation", "face", "palm", "person facepalming"}},
+	"man_facepalming":                 {Name: "man facepalming", Keywords: []string{"disbelief", "exasperation", "face", "man", "man facepalming", "palm"}},
+	"woman_facepalming":               {Name: "woman facepalming", Keywords: []string{"disbelief", "exasperation", "face", "palm", "woman", "woman facepalming"}},
+
+	// === PEOPLE ===
+	"eyes": {Name: "eyes", Keywords: []string{"eye", "eyes", "face"}},
+
+	// === HEARTS ===
+	"heart":           {Name: "red heart", Keywords: []string{"heart", "love", "red heart"}},
+	"yellow_heart":    {Name: "yellow heart", Keywords: []string{"yellow", "yellow heart"}},
+	"green_heart":     {Name: "green heart", Keywords: []string{"green", "green heart"}},
+	"blue_heart":      {Name: "blue heart", Keywords: []string{"blue", "blue heart"}},
+	"purple_heart":    {Name: "purple heart", Keywords: []string{"purple", "purple heart"}},
+	"black_heart":     {Name: "black heart", Keywords: []string{"black", "black heart", "evil", "wicked"}},
+	"broken_heart":    {Name: "broken heart", Keywords: []string{"break", "broken", "broken heart"}},
+	"two_hearts":      {Name: "two hearts", Keywords: []string{"love", "two hearts"}},
+	"sparkling_heart": {Name: "sparkling heart", Keywords: []string{"excited", "sparkle", "sparkling heart"}},
+	"heartpulse":      {Name: "growing heart", Keywords: []string{"excited", "growing", "growing heart", "nervous", "pulse"}},
+	"cupid":           {Name: "heart with arrow", Keywords: []string{"arrow", "cupid", "heart with arrow"}},
+
+	// === SYMBOLS ===
+	"star":     {Name: "star", Keywords: []string{"star"}},
+	"star2":    {Name: "glowing star", Keywords: []string{"glittery", "glow", "glowing star", "shining", "sparkle", "star"}},
+	"fire":     {Name: "fire", Keywords: []string{"fire", "flame", "tool"}},
+	"boom":     {Name: "collision", Keywords: []string{"boom", "collision", "comic"}},
+	"sparkles": {Name: "sparkles", Keywords: []string{"sparkle", "sparkles", "star"}},
+	"zap":      {Name: "high voltage", Keywords: []string{"danger", "electric", "high voltage", "lightning", "voltage", "zap"}},
+	"gem":      {Name: "gem stone", Keywords: []string{"diamond", "gem", "gem stone", "jewel"}},
+	"bomb":     {Name: "bomb", Keywords: []string{"bomb", "comic"}},
+
+	// === NATURE ===
+	"sunflower":              {Name: "sunflower", Keywords: []string{"flower", "sun", "sunflower"}},
+	"rose":                   {Name: "rose", Keywords: []string{"flower", "rose"}},
+	"tulip":                  {Name: "tulip", Keywords: []string{"flower", "tulip"}},
+	"cherry_blossom":         {Name: "cherry blossom", Keywords: []string{"blossom", "cherry", "flower"}},
+	"blossom":                {Name: "blossom", Keywords: []string{"blossom", "flower"}},
+	"hibiscus":               {Name: "hibiscus", Keywords: []string{"flower", "hibiscus"}},
+	"sun":                    {Name: "sun", Keywords: []string{"bright", "rays", "sun", "sunny"}},
+	"sun_with_face":          {Name: "sun with face", Keywords: []string{"bright", "face", "sun", "sun with face"}},
+	"sunrise":                {Name: "sunrise", Keywords: []string{"morning", "sun", "sunrise"}},
+	"sunrise_over_mountains": {Name: "sunrise over mountains", Keywords: []string{"morning", "mountain", "sun", "sunrise", "sunrise over mountains"}},
+	"moon":                   {Name: "crescent moon", Keywords: []string{"crescent", "moon"}},
+	"full_moon":              {Name: "full moon", Keywords: []string{"full", "moon"}},
+	"new_moon":               {Name: "new moon", Keywords: []string{"dark", "moon", "new moon"}},
+	"partly_sunny":           {Name: "sun behind cloud", Keywords: []string{"cloud", "sun", "sun behind cloud"}},
+	"cloud":                  {Name: "cloud", Keywords: []string{"cloud", "weather"}},
+	"rain_cloud":             {Name: "cloud with rain", Keywords: []string{"cloud", "cloud with rain", "rain"}},
+	"snowman":                {Name: "snowman without snow", Keywords: []string{"cold", "snow", "snowman", "snowman without snow"}},
+	"snowflake":              {Name: "snowflake", Keywords: []string{"cold", "snow", "snowflake"}},
+	"rainbow":                {Name: "rainbow", Keywords: []string{"rain", "rainbow"}},
+
+	// === EARTH & GEOGRAPHY ===
+	"earth_africa":       {Name: "globe showing Europe-Africa", Keywords: []string{"Africa", "earth", "Europe", "globe", "globe showing Europe-Africa", "world"}},
+	"earth_americas":     {Name: "globe showing Americas", Keywords: []string{"Americas", "earth", "globe", "globe showing Americas", "world"}},
+	"earth_asia":         {Name: "globe showing Asia-Australia", Keywords: []string{"Asia", "Australia", "earth", "globe", "globe showing Asia-Australia", "world"}},
+	"desert_island":      {Name: "desert island", Keywords: []string{"desert", "island"}},
+	"classical_building": {Name: "classical building", Keywords: []string{"classical", "classical building"}},
+
+	// === WATER & WAVES ===
+	"ocean":       {Name: "water wave", Keywords: []string{"ocean", "water", "wave"}},
+	"droplet":     {Name: "droplet", Keywords: []string{"cold", "comic", "drop", "droplet", "sweat"}},
+	"sweat_drops": {Name: "sweat droplets", Keywords: []string{"comic", "splashing", "sweat", "sweat droplets"}},
+
+	// === PLANTS & TREES ===
+	"seedling":         {Name: "seedling", Keywords: []string{"seedling", "young"}},
+	"herb":             {Name: "herb", Keywords: []string{"herb", "leaf"}},
+	"four_leaf_clover": {Name: "four leaf clover", Keywords: []string{"4", "clover", "four", "four-leaf clover", "leaf"}},
+	"leaves":           {Name: "leaf fluttering in wind", Keywords: []string{"blow", "flutter", "leaf", "leaf fluttering in wind", "wind"}},
+	"evergreen_tree":   {Name: "evergreen tree", Keywords: []string{"evergreen tree", "tree"}},
+	"deciduous_tree":   {Name: "deciduous tree", Keywords: []string{"deciduous", "shedding", "tree"}},
+	"palm_tree":        {Name: "palm tree", Keywords: []string{"palm", "tree"}},
+	"cactus":           {Name: "cactus", Keywords: []string{"cactus", "plant"}},
+
+	// === FOOD ===
+	"apple":      {Name: "red apple", Keywords: []string{"apple", "fruit", "red"}},
+	"banana":     {Name: "banana", Keywords: []string{"banana", "fruit"}},
+	"grapes":     {Name: "grapes", Keywords: []string{"fruit", "grape", "grapes"}},
+	"strawberry": {Name: "strawberry", Keywords: []string{"berry", "fruit", "strawberry"}},
+	"watermelon": {Name: "watermelon", Keywords: []string{"fruit", "watermelon"}},
+	"orange":     {Name: "tangerine", Keywords: []string{"fruit", "orange", "tangerine"}},
+	"lemon":      {Name: "lemon", Keywords: []string{"citrus", "fruit", "lemon"}},
+	"peach":      {Name: "peach", Keywords: []string{"fruit", "peach"}},
+	"cherries":   {Name: "cherries", Keywords: []string{"berries", "cherries", "cherry", "fruit", "red"}},
+	"pineapple":  {Name: "pineapple", Keywords: []string{"fruit", "pineapple"}},
+	"pizza":      {Name: "pizza", Keywords: []string{"cheese", "pizza", "slice"}},
+	"hamburger":  {Name: "hamburger", Keywords: []string{"burger", "hamburger"}},
+	"hotdog":     {Name: "hot dog", Keywords: []string{"frankfurter", "hot dog", "hotdog", "sausage"}},
+	"taco":       {Name: "taco", Keywords: []string{"mexican", "taco"}},
+	"burrito":    {Name: "burrito", Keywords: []string{"burrito", "mexican", "wrap"}},
+
+	// === DRINKS ===
+	"coffee":     {Name: "hot beverage", Keywords: []string{"beverage", "coffee", "drink", "hot", "steaming", "tea"}},
+	"tea":        {Name: "teacup without handle", Keywords: []string{"beverage", "cup", "drink", "tea", "teacup", "teacup without handle"}},
+	"beer":       {Name: "beer mug", Keywords: []string{"bar", "beer", "drink", "mug"}},
+	"beers":      {Name: "clinking beer mugs", Keywords: []string{"bar", "beer", "clink", "clinking beer mugs", "drink", "mug"}},
+	"wine_glass": {Name: "wine glass", Keywords: []string{"bar", "beverage", "drink", "glass", "wine"}},
+	"cocktail":   {Name: "cocktail glass", Keywords: []string{"bar", "cocktail", "drink", "glass"}},
+
+	// === SPORTS & ACTIVITIES ===
+	"soccer":     {Name: "soccer ball", Keywords: []string{"ball", "football", "soccer"}},
+	"basketball": {Name: "basketball", Keywords: []string{"ball", "hoop"}},
+	"football":   {Name: "american football", Keywords: []string{"american", "ball", "football"}},
+	"tennis":     {Name: "tennis", Keywords: []string{"ball", "racquet", "tennis"}},
+	"8ball":      {Name: "pool 8 ball", Keywords: []string{"8", "ball", "billiard", "eight", "game", "pool 8 ball"}},
+	"golf":       {Name: "flag in hole", Keywords: []string{"flag in hole", "golf", "hole"}},
+
+	// === TRANSPORTATION ===
+	"car":        {Name: "automobile", Keywords: []string{"automobile", "car"}},
+	"taxi":       {Name: "taxi", Keywords: []string{"taxi", "vehicle"}},
+	"bus":        {Name: "bus", Keywords: []string{"bus", "vehicle"}},
+	"train":      {Name: "train", Keywords: []string{"railway", "train"}},
+	"airplane":   {Name: "airplane", Keywords: []string{"aeroplane", "airplane"}},
+	"rocket":     {Name: "rocket", Keywords: []string{"rocket", "space"}},
+	"ship":       {Name: "ship", Keywords: []string{"boat", "passenger", "ship"}},
+	"bicycle":    {Name: "bicycle", Keywords: []string{"bicycle", "bike"}},
+	"scooter":    {Name: "motor scooter", Keywords: []string{"motor", "scooter"}},
+	"motorcycle": {Name: "motorcycle", Keywords: []string{"motorcycle", "racing"}},
+	"racing_car": {Name: "racing car", Keywords: []string{"car", "racing"}},
+
+	// === OBJECTS ===
+	"calendar":           {Name: "calendar", Keywords: []string{"calendar", "date"}},
+	"phone":              {Name: "mobile phone", Keywords: []string{"cell", "mobile", "phone", "telephone"}},
+	"computer":           {Name: "laptop", Keywords: []string{"computer", "laptop", "pc", "personal"}},
+	"desktop_computer":   {Name: "desktop computer", Keywords: []string{"computer", "desktop"}},
+	"floppy_disk":        {Name: "floppy disk", Keywords: []string{"computer", "disk", "floppy"}},
+	"keyboard":           {Name: "keyboard", Keywords: []string{"computer", "keyboard"}},
+	"mouse_three_button": {Name: "computer mouse", Keywords: []string{"computer", "computer mouse"}},
+	"camera":             {Name: "camera", Keywords: []string{"camera", "video"}},
+	"camera_flash":       {Name: "camera with flash", Keywords: []string{"camera", "camera with flash", "flash", "video"}},
+	"tv":                 {Name: "television", Keywords: []string{"television", "tv", "video"}},
+	"radio":              {Name: "radio", Keywords: []string{"radio", "video"}},
+	"headphones":         {Name: "headphone", Keywords: []string{"earbud", "headphone"}},
+	"microphone":         {Name: "microphone", Keywords: []string{"karaoke", "mic", "microphone"}},
+	"studio_microphone":  {Name: "studio microphone", Keywords: []string{"mic", "microphone", "studio"}},
+	"chair":              {Name: "chair", Keywords: []string{"chair", "seat", "sit"}},
+	"musical_note":       {Name: "musical note", Keywords: []string{"music", "musical note", "note"}},
+	"notes":              {Name: "musical notes", Keywords: []string{"music", "musical notes", "note", "notes"}},
+	"guitar":             {Name: "guitar", Keywords: []string{"guitar", "instrument", "music"}},
+	"trumpet":            {Name: "trumpet", Keywords: []string{"instrument", "music", "trumpet"}},
+	"saxophone":          {Name: "saxophone", Keywords: []string{"instrument", "music", "sax", "saxophone"}},
+
+	// === KEYCAPS ===
+	"hash":       {Name: "keycap: #", Keywords: []string{"keycap"}},
+	"asterisk":   {Name: "keycap: *", Keywords: []string{"keycap"}},
+	"zero":       {Name: "keycap: 0", Keywords: []string{"keycap"}},
+	"one":        {Name: "keycap: 1", Keywords: []string{"keycap"}},
+	"two":        {Name: "keycap: 2", Keywords: []string{"keycap"}},
+	"three":      {Name: "keycap: 3", Keywords: []string{"keycap"}},
+	"four":       {Name: "keycap: 4", Keywords: []string{"keycap"}},
+	"five":       {Name: "keycap: 5", Keywords: []string{"keycap"}},
+	"six":        {Name: "keycap: 6", Keywords: []string{"keycap"}},
+	"seven":      {Name: "keycap: 7", Keywords: []string{"keycap"}},
+	"eight":      {Name: "keycap: 8", Keywords: []string{"keycap"}},
+	"nine":       {Name: "keycap: 9", Keywords: []string{"keycap"}},
+	"keycap_ten": {Name: "keycap: 10", Keywords: []string{"keycap"}},
+
+	// === FLAGS BY CONTINENT ===
+
+	// === NORTH AMERICA ===
+	"flag_us": {Name: "flag: United States", Keywords: []string{"flag", "United States"}},
+
+	// === EUROPE ===
+	"flag_gb":     {Name: "flag: United Kingdom", Keywords: []string{"flag", "United Kingdom"}},
+	"flag_gb_eng": {Name: "flag: England", Keywords: []string{"flag", "England"}},
+	"flag_gb_sct": {Name: "flag: Scotland", Keywords: []string{"flag", "Scotland"}},
+	"flag_gb_wls": {Name: "flag: Wales", Keywords: []string{"flag", "Wales"}},
+	"flag_fr":     {Name: "flag: France", Keywords: []string{"flag", "France"}},
+	"flag_it":     {Name: "flag: Italy", Keywords: []string{"flag", "Italy"}},
+	"flag_de":     {Name: "flag: Germany", Keywords: []string{"flag", "Germany"}},
+	"flag_es":     {Name: "flag: Spain", Keywords: []string{"flag", "Spain"}},
+
+	// === ASIA ===
+	"flag_jp": {Name: "flag: Japan", Keywords: []string{"flag", "Japan"}},
+	"flag_cn": {Name: "flag: China", Keywords: []string{"flag", "China"}},
+
+	// === SOUTH AMERICA ===
+	"flag_co": {Name: "flag: Colombia", Keywords: []string{"flag", "Colombia"}},
+	"flag_ar": {Name: "flag: Argentina", Keywords: []string{"flag", "Argentina"}},
+	"flag_mx": {Name: "flag: Mexico", Keywords: []string{"flag", "Mexico"}},
+	"flag_br": {Name: "flag: Brazil", Keywords: []string{"flag", "Brazil"}},
+}
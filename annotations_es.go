@@ -0,0 +1,332 @@
+package gomoji
+
+// Spanish CLDR annotations (short names and keywords) and shortcodes, keyed by emoji name
+var emojiAnnotationsES = map[string]annotation{
+	// === HAPPY FACE EMOJIS ===
+	"grinning":      {Name: "cara sonriendo", Shortcode: ":cara_sonriente:", Keywords: []string{"cara", "sonrisa", "feliz"}},
+	"grinning_eyes": {Name: "cara radiante con ojos sonrientes", Shortcode: ":cara_radiante:", Keywords: []string{"cara", "ojos", "sonrisa", "feliz"}},
+	"joy":           {Name: "cara llorando de risa", Shortcode: ":risa:", Keywords: []string{"cara", "lágrima", "risa", "carcajada"}},
+	"smiley":        {Name: "cara sonriendo con ojos grandes", Shortcode: ":sonrisa_grande:", Keywords: []string{"cara", "boca", "sonrisa", "feliz"}},
+	"smile":         {Name: "cara sonriendo con ojos sonrientes", Shortcode: ":sonrisa:", Keywords: []string{"cara", "ojos", "sonrisa", "feliz"}},
+	"sweat_smile":   {Name: "cara sonriendo con sudor frío", Shortcode: ":sonrisa_sudor:", Keywords: []string{"cara", "sonrisa", "sudor", "frío"}},
+	"laughing":      {Name: "cara sonriendo con los ojos cerrados", Shortcode: ":riendo:", Keywords: []string{"cara", "risa", "sonrisa", "feliz"}},
+	"wink":          {Name: "cara guiñando el ojo", Shortcode: ":guino:", Keywords: []string{"cara", "guiño"}},
+	"blush":         {Name: "cara feliz con ojos sonrientes", Shortcode: ":sonrojo:", Keywords: []string{"cara", "ojos", "sonrisa", "feliz"}},
+	"yum":           {Name: "cara saboreando comida", Shortcode: ":delicioso:", Keywords: []string{"cara", "comida", "delicioso", "rico"}},
+
+	// === NEUTRAL/COOL FACE EMOJIS ===
+	"sunglasses":           {Name: "cara sonriendo con gafas de sol", Shortcode: ":gafas_de_sol:", Keywords: []string{"cara", "gafas", "sol", "genial"}},
+	"heart_eyes":           {Name: "cara sonriendo con ojos de corazón", Shortcode: ":enamorado:", Keywords: []string{"cara", "amor", "corazón", "ojos"}},
+	"kissing_heart":        {Name: "cara lanzando un beso", Shortcode: ":beso_corazon:", Keywords: []string{"cara", "beso"}},
+	"kissing":              {Name: "cara besando", Shortcode: ":beso:", Keywords: []string{"cara", "beso"}},
+	"kissing_smiling_eyes": {Name: "cara besando con ojos sonrientes", Shortcode: ":beso_sonriente:", Keywords: []string{"cara", "beso", "ojos", "sonrisa"}},
+	"kissing_closed_eyes":  {Name: "cara besando con los ojos cerrados", Shortcode: ":beso_ojos_cerrados:", Keywords: []string{"cara", "beso", "ojos", "cerrados"}},
+	"relaxed":              {Name: "cara sonriente", Shortcode: ":relajado:", Keywords: []string{"cara", "sonrisa", "contorno"}},
+	"slight_smile":         {Name: "cara sonriendo ligeramente", Shortcode: ":sonrisa_leve:", Keywords: []string{"cara", "sonrisa"}},
+	"upside_down":          {Name: "cara al revés", Shortcode: ":al_reves:", Keywords: []string{"cara", "revés"}},
+
+	// === THINKING/NEUTRAL FACE EMOJIS ===
+	"thinking":       {Name: "cara pensativa", Shortcode: ":pensando:", Keywords: []string{"cara", "pensar"}},
+	"neutral_face":   {Name: "cara neutral", Shortcode: ":neutral:", Keywords: []string{"cara", "inexpresivo", "neutral"}},
+	"expressionless": {Name: "cara sin expresión", Shortcode: ":inexpresivo:", Keywords: []string{"cara", "inexpresivo", "sin expresión"}},
+	"no_mouth":       {Name: "cara sin boca", Shortcode: ":sin_boca:", Keywords: []string{"cara", "boca", "callado", "silencio"}},
+
+	// === SAD/CONCERNED FACE EMOJIS ===
+	"confused":          {Name: "cara de confusión", Shortcode: ":confundido:", Keywords: []string{"cara", "confusión", "confundido"}},
+	"worried":           {Name: "cara preocupada", Shortcode: ":preocupado:", Keywords: []string{"cara", "preocupación"}},
+	"slightly_frowning": {Name: "cara con el ceño ligeramente fruncido", Shortcode: ":ceno_leve:", Keywords: []string{"cara", "ceño", "triste"}},
+	"frowning":          {Name: "cara con el ceño fruncido", Shortcode: ":ceno_fruncido:", Keywords: []string{"cara", "ceño", "triste"}},
+	"persevere":         {Name: "cara desesperada", Shortcode: ":desesperado:", Keywords: []string{"cara", "desesperación"}},
+	"confounded":        {Name: "cara de frustración", Shortcode: ":frustrado:", Keywords: []string{"cara", "frustración"}},
+	"tired_face":        {Name: "cara cansada", Shortcode: ":cansado:", Keywords: []string{"cara", "cansancio"}},
+	"weary":             {Name: "cara agotada", Shortcode: ":agotado:", Keywords: []string{"cara", "agotamiento", "cansancio"}},
+	"cry":               {Name: "cara llorando", Shortcode: ":llorando:", Keywords: []string{"cara", "lágrima", "llorar", "triste"}},
+	"sob":               {Name: "cara llorando fuerte", Shortcode: ":llanto:", Keywords: []string{"cara", "lágrima", "llanto", "triste"}},
+
+	// === ANGRY/UPSET FACE EMOJIS ===
+	"angry":   {Name: "cara enfadada", Shortcode: ":enfadado:", Keywords: []string{"cara", "enfado", "enojo"}},
+	"rage":    {Name: "cara cabreada", Shortcode: ":furioso:", Keywords: []string{"cara", "enfado", "furia", "rojo"}},
+	"triumph": {Name: "cara resoplando", Shortcode: ":resoplando:", Keywords: []string{"cara", "triunfo", "resoplido"}},
+
+	// === SURPRISED/SHOCKED FACE EMOJIS ===
+	"open_mouth": {Name: "cara con la boca abierta", Shortcode: ":boca_abierta:", Keywords: []string{"cara", "boca", "abierta", "sorpresa"}},
+	"scream":     {Name: "cara gritando de miedo", Shortcode: ":grito:", Keywords: []string{"cara", "miedo", "grito", "pánico"}},
+	"fearful":    {Name: "cara asustada", Shortcode: ":asustado:", Keywords: []string{"cara", "miedo", "susto"}},
+	"cold_sweat": {Name: "cara con ansiedad y sudor", Shortcode: ":sudor_frio:", Keywords: []string{"cara", "ansiedad", "sudor", "frío"}},
+	"hushed":     {Name: "cara estupefacta", Shortcode: ":estupefacto:", Keywords: []string{"cara", "sorpresa", "estupefacto"}},
+	"flushed":    {Name: "cara sonrojada", Shortcode: ":sonrojado:", Keywords: []string{"cara", "sonrojo", "vergüenza"}},
+
+	// === SICK/UNWELL FACE EMOJIS ===
+	"dizzy_face": {Name: "cara mareada", Shortcode: ":mareado:", Keywords: []string{"cara", "mareo"}},
+	"mask":       {Name: "cara con mascarilla médica", Shortcode: ":mascarilla:", Keywords: []string{"cara", "médico", "mascarilla", "enfermo"}},
+
+	// === ANIMALS ===
+	"dog":           {Name: "cara de perro", Shortcode: ":perro:", Keywords: []string{"cara", "mascota", "perro"}},
+	"cat":           {Name: "cara de gato", Shortcode: ":gato:", Keywords: []string{"cara", "gato", "mascota"}},
+	"mouse":         {Name: "cara de ratón", Shortcode: ":raton:", Keywords: []string{"cara", "ratón"}},
+	"hamster":       {Name: "hámster", Shortcode: ":hamster:", Keywords: []string{"cara", "hámster", "mascota"}},
+	"rabbit":        {Name: "cara de conejo", Shortcode: ":conejo:", Keywords: []string{"cara", "conejo", "mascota"}},
+	"bear":          {Name: "oso", Shortcode: ":oso:", Keywords: []string{"cara", "oso"}},
+	"panda_face":    {Name: "panda", Shortcode: ":panda:", Keywords: []string{"cara", "panda"}},
+	"koala":         {Name: "koala", Shortcode: ":koala:", Keywords: []string{"cara", "koala", "marsupial"}},
+	"tiger":         {Name: "cara de tigre", Shortcode: ":tigre:", Keywords: []string{"cara", "tigre"}},
+	"lion_face":     {Name: "león", Shortcode: ":leon:", Keywords: []string{"cara", "león", "Leo", "zodiaco"}},
+	"cow":           {Name: "cara de vaca", Shortcode: ":vaca:", Keywords: []string{"cara", "vaca"}},
+	"pig":           {Name: "cara de cerdo", Shortcode: ":cerdo:", Keywords: []string{"cara", "cerdo"}},
+	"pig_nose":      {Name: "nariz de cerdo", Shortcode: ":nariz_de_cerdo:", Keywords: []string{"cara", "cerdo", "nariz"}},
+	"frog":          {Name: "rana", Shortcode: ":rana:", Keywords: []string{"cara", "rana"}},
+	"octopus":       {Name: "pulpo", Shortcode: ":pulpo:", Keywords: []string{"pulpo"}},
+	"monkey_face":   {Name: "cara de mono", Shortcode: ":mono:", Keywords: []string{"cara", "mono"}},
+	"see_no_evil":   {Name: "mono con los ojos tapados", Shortcode: ":no_ver:", Keywords: []string{"mono", "ojos", "prohibido", "ver"}},
+	"hear_no_evil":  {Name: "mono con los oídos tapados", Shortcode: ":no_oir:", Keywords: []string{"mono", "oídos", "prohibido", "oír"}},
+	"speak_no_evil": {Name: "mono con la boca tapada", Shortcode: ":no_hablar:", Keywords: []string{"mono", "boca", "prohibido", "hablar"}},
+
+	// === HANDS AND GESTURES ===
+	"thumbs_up":        {Name: "pulgar hacia arriba", Shortcode: ":pulgar_arriba:", Keywords: []string{"mano", "pulgar", "arriba", "me gusta"}},
+	"thumbs_down":      {Name: "pulgar hacia abajo", Shortcode: ":pulgar_abajo:", Keywords: []string{"mano", "pulgar", "abajo", "no me gusta"}},
+	"clap":             {Name: "manos aplaudiendo", Shortcode: ":aplausos:", Keywords: []string{"aplauso", "manos"}},
+	"raised_hands":     {Name: "manos levantadas celebrando", Shortcode: ":celebracion:", Keywords: []string{"celebración", "manos", "hurra"}},
+	"open_hands":       {Name: "manos abiertas", Shortcode: ":manos_abiertas:", Keywords: []string{"manos", "abiertas"}},
+	"point_up":         {Name: "dedo índice hacia arriba", Shortcode: ":indice_arriba:", Keywords: []string{"dedo", "índice", "mano", "arriba"}},
+	"point_down":       {Name: "dorso de mano con índice hacia abajo", Shortcode: ":indice_abajo:", Keywords: []string{"dedo", "índice", "mano", "abajo"}},
+	"point_left":       {Name: "dorso de mano con índice a la izquierda", Shortcode: ":indice_izquierda:", Keywords: []string{"dedo", "índice", "mano", "izquierda"}},
+	"point_right":      {Name: "dorso de mano con índice a la derecha", Shortcode: ":indice_derecha:", Keywords: []string{"dedo", "índice", "mano", "derecha"}},
+	"ok_hand":          {Name: "señal de aprobación con la mano", Shortcode: ":ok:", Keywords: []string{"mano", "aprobación", "OK"}},
+	"peace":            {Name: "mano con señal de victoria", Shortcode: ":victoria:", Keywords: []string{"mano", "victoria", "paz"}},
+	"crossed_fingers":  {Name: "dedos cruzados", Shortcode: ":dedos_cruzados:", Keywords: []string{"dedos", "cruzados", "suerte"}},
+	"metal":            {Name: "mano haciendo el signo de cuernos", Shortcode: ":cuernos:", Keywords: []string{"cuernos", "mano", "rock"}},
+	"call_me":          {Name: "mano haciendo el gesto de llamar", Shortcode: ":llamame:", Keywords: []string{"llamar", "mano", "teléfono"}},
+	"fist":             {Name: "puño en alto", Shortcode: ":puno_en_alto:", Keywords: []string{"puño", "mano", "alto"}},
+	"punch":            {Name: "puño cerrado", Shortcode: ":puno:", Keywords: []string{"puño", "golpe", "mano"}},
+	"left_fist":        {Name: "puño hacia la izquierda", Shortcode: ":puno_izquierda:", Keywords: []string{"puño", "izquierda"}},
+	"right_fist":       {Name: "puño hacia la derecha", Shortcode: ":puno_derecha:", Keywords: []string{"puño", "derecha"}},
+	"wave":             {Name: "mano saludando", Shortcode: ":saludo:", Keywords: []string{"mano", "saludo", "hola", "adiós"}},
+	"pray":             {Name: "manos en oración", Shortcode: ":rezar:", Keywords: []string{"manos", "oración", "rezar", "gracias", "por favor"}},
+	"raised_hand":      {Name: "mano levantada", Shortcode: ":mano_levantada:", Keywords: []string{"mano", "levantada", "choca esos cinco"}},
+	"hand_splayed":     {Name: "mano abierta", Shortcode: ":mano_abierta:", Keywords: []string{"mano", "dedos", "abierta"}},
+	"vulcan":           {Name: "saludo vulcano", Shortcode: ":saludo_vulcano:", Keywords: []string{"mano", "spock", "vulcano"}},
+	"love_you_gesture": {Name: "gesto de te quiero", Shortcode: ":te_quiero:", Keywords: []string{"mano", "te quiero"}},
+	"pinching_hand":    {Name: "mano pellizcando", Shortcode: ":pellizco:", Keywords: []string{"mano", "pellizco", "poco"}},
+	"pinched_fingers":  {Name: "dedos juntos apuntando hacia arriba", Shortcode: ":dedos_juntos:", Keywords: []string{"dedos", "mano", "gesto", "italiano"}},
+
+	// === PEOPLE ===
+	"woman_teacher":                   {Name: "profesora: tono de piel medio", Shortcode: ":profesora:", Keywords: []string{"profesora", "maestra", "mujer", "tono de piel medio"}},
+	"woman_teacher_default_skin_tone": {Name: "profesora", Shortcode: ":maestra:", Keywords: []string{"profesora", "maestra", "mujer"}},
+	"man_teacher":                     {Name: "profesor", Shortcode: ":profesor:", Keywords: []string{"profesor", "maestro", "hombre"}},
+	"man_teacher_medium_skin_tone":    {Name: "profesor: tono de piel medio", Shortcode: ":profesor_tono_medio:", Keywords: []string{"profesor", "maestro", "hombre", "tono de piel medio"}},
+	"teacher":                         {Name: "docente", Shortcode: ":docente:", Keywords: []string{"docente", "educador", "profesor"}},
+	"teacher_medium_skin_tone":        {Name: "docente: tono de piel medio", Shortcode: ":docente_tono_medio:", Keywords: []string{"docente", "educador", "profesor", "tono de piel medio"}},
+	"person":                          {Name: "persona adulta", Shortcode: ":persona:", Keywords: []string{"persona", "adulto", "género neutro"}},
+	"man":                             {Name: "hombre", Shortcode: ":hombre:", Keywords: []string{"hombre", "adulto"}},
+	"woman":                           {Name: "mujer", Shortcode: ":mujer:", Keywords: []string{"mujer", "adulta"}},
+	"person_red_hair":                 {Name: "persona adulta: pelo pelirrojo", Shortcode: ":persona_pelirrojo:", Keywords: []string{"persona", "pelo pelirrojo"}},
+	"person_curly_hair":               {Name: "persona adulta: pelo rizado", Shortcode: ":persona_pelo_rizado:", Keywords: []string{"persona", "pelo rizado"}},
+	"person_white_hair":               {Name: "persona adulta: pelo blanco", Shortcode: ":persona_pelo_blanco:", Keywords: []string{"persona", "pelo blanco"}},
+	"person_bald":                     {Name: "persona adulta: sin pelo", Shortcode: ":persona_calvo:", Keywords: []string{"persona", "sin pelo"}},
+	"man_red_hair":                    {Name: "hombre: pelo pelirrojo", Shortcode: ":hombre_pelirrojo:", Keywords: []string{"hombre", "pelo pelirrojo"}},
+	"man_curly_hair":                  {Name: "hombre: pelo rizado", Shortcode: ":hombre_pelo_rizado:", Keywords: []string{"hombre", "pelo rizado"}},
+	"man_white_hair":                  {Name: "hombre: pelo blanco", Shortcode: ":hombre_pelo_blanco:", Keywords: []string{"hombre", "pelo blanco"}},
+	"man_bald":                        {Name: "hombre: sin pelo", Shortcode: ":hombre_calvo:", Keywords: []string{"hombre", "sin pelo"}},
+	"woman_red_hair":                  {Name: "mujer: pelo pelirrojo", Shortcode: ":mujer_pelirrojo:", Keywords: []string{"mujer", "pelo pelirrojo"}},
+	"woman_curly_hair":                {Name: "mujer: pelo rizado", Shortcode: ":mujer_pelo_rizado:", Keywords: []string{"mujer", "pelo rizado"}},
+	"woman_white_hair":                {Name: "mujer: pelo blanco", Shortcode: ":mujer_pelo_blanco:", Keywords: []string{"mujer", "pelo blanco"}},
+	"woman_bald":                      {Name: "mujer: sin pelo", Shortcode: ":mujer_calvo:", Keywords: []string{"mujer", "sin pelo"}},
+	"firefighter":                     {Name: "bombero", Shortcode: ":profesional_bomberos:", Keywords: []string{"bombero", "fuego"}},
+	"man_firefighter":                 {Name: "bombero hombre", Shortcode: ":bombero:", Keywords: []string{"bombero", "fuego", "hombre"}},
+	"woman_firefighter":               {Name: "bombera", Shortcode: ":bombera:", Keywords: []string{"bombero", "fuego", "mujer"}},
+	"health_worker":                   {Name: "profesional sanitario", Shortcode: ":profesional_sanitario:", Keywords: []string{"médico", "enfermero", "salud"}},
+	"man_health_worker":               {Name: "profesional sanitario hombre", Shortcode: ":sanitario:", Keywords: []string{"médico", "enfermero", "salud", "hombre"}},
+	"woman_health_worker":             {Name: "profesional sanitario mujer", Shortcode: ":sanitaria:", Keywords: []string{"médico", "enfermero", "salud", "mujer"}},
+	"cook":                            {Name: "chef", Shortcode: ":chef:", Keywords: []string{"chef", "cocina"}},
+	"man_cook":                        {Name: "chef hombre", Shortcode: ":cocinero:", Keywords: []string{"chef", "cocina", "hombre"}},
+	"woman_cook":                      {Name: "chef mujer", Shortcode: ":cocinera:", Keywords: []string{"chef", "cocina", "mujer"}},
+	"technologist":                    {Name: "profesional de la tecnología", Shortcode: ":profesional_tecnologia:", Keywords: []string{"programador", "tecnología", "software"}},
+	"man_technologist":                {Name: "profesional de la tecnología hombre", Shortcode: ":tecnologo:", Keywords: []string{"programador", "tecnología", "software", "hombre"}},
+	"woman_technologist":              {Name: "profesional de la tecnología mujer", Shortcode: ":tecnologa:", Keywords: []string{"programador", "tecnología", "software", "mujer"}},
+	"astronaut":                       {Name: "astronauta", Shortcode: ":astronauta:", Keywords: []string{"astronauta", "cohete", "espacio"}},
+	"man_astronaut":                   {Name: "astronauta hombre", Shortcode: ":astronauta_hombre:", Keywords: []string{"astronauta", "cohete", "espacio", "hombre"}},
+	"woman_astronaut":                 {Name: "astronauta mujer", Shortcode: ":astronauta_mujer:", Keywords: []string{"astronauta", "cohete", "espacio", "mujer"}},
+	"person_shrugging":                {Name: "persona encogida de hombros", Shortcode: ":encogimiento_de_hombros:", Keywords: []string{"duda", "indiferencia", "hombros"}},
+	"man_shrugging":                   {Name: "hombre encogido de hombros", Shortcode: ":hombre_encogido_de_hombros:", Keywords: []string{"duda", "indiferencia", "hombros", "hombre"}},
+	"woman_shrugging":                 {Name: "mujer encogida de hombros", Shortcode: ":mujer_encogida_de_hombros:", Keywords: []string{"duda", "indiferencia", "hombros", "mujer"}},
+	"person_facepalming":              {Name: "persona con la mano en la frente", Shortcode: ":mano_en_la_frente:", Keywords: []string{"exasperación", "incredulidad", "mano"}},
+	"man_facepalming":                 {Name: "hombre con la mano en la frente", Shortcode: ":hombre_mano_en_la_frente:", Keywords: []string{"exasperación", "incredulidad", "mano", "hombre"}},
+	"woman_facepalming":               {Name: "mujer con la mano en la frente", Shortcode: ":mujer_mano_en_la_frente:", Keywords: []string{"exasperación", "incredulidad", "mano", "mujer"}},
+
+	// === PEOPLE ===
+	"eyes": {Name: "ojos", Shortcode: ":ojos:", Keywords: []string{"cara", "ojos", "mirar"}},
+
+	// === HEARTS ===
+	"heart":           {Name: "corazón rojo", Shortcode: ":corazon:", Keywords: []string{"corazón", "amor", "rojo"}},
+	"yellow_heart":    {Name: "corazón amarillo", Shortcode: ":corazon_amarillo:", Keywords: []string{"corazón", "amarillo"}},
+	"green_heart":     {Name: "corazón verde", Shortcode: ":corazon_verde:", Keywords: []string{"corazón", "verde"}},
+	"blue_heart":      {Name: "corazón azul", Shortcode: ":corazon_azul:", Keywords: []string{"corazón", "azul"}},
+	"purple_heart":    {Name: "corazón morado", Shortcode: ":corazon_morado:", Keywords: []string{"corazón", "morado"}},
+	"black_heart":     {Name: "corazón negro", Shortcode: ":corazon_negro:", Keywords: []string{"corazón", "negro", "malvado"}},
+	"broken_heart":    {Name: "corazón roto", Shortcode: ":corazon_roto:", Keywords: []string{"corazón", "roto", "ruptura"}},
+	"two_hearts":      {Name: "dos corazones", Shortcode: ":dos_corazones:", Keywords: []string{"amor", "corazones"}},
+	"sparkling_heart": {Name: "corazón brillante", Shortcode: ":corazon_brillante:", Keywords: []string{"corazón", "brillante", "emocionado"}},
+	"heartpulse":      {Name: "corazón creciente", Shortcode: ":corazon_creciente:", Keywords: []string{"corazón", "creciente", "latido"}},
+	"cupid":           {Name: "corazón con flecha", Shortcode: ":cupido:", Keywords: []string{"corazón", "flecha", "cupido"}},
+
+	// === SYMBOLS ===
+	"star":     {Name: "estrella", Shortcode: ":estrella:", Keywords: []string{"estrella"}},
+	"star2":    {Name: "estrella brillante", Shortcode: ":estrella_brillante:", Keywords: []string{"estrella", "brillante", "brillo"}},
+	"fire":     {Name: "fuego", Shortcode: ":fuego:", Keywords: []string{"fuego", "llama"}},
+	"boom":     {Name: "colisión", Shortcode: ":explosion:", Keywords: []string{"colisión", "explosión", "cómic"}},
+	"sparkles": {Name: "chispas", Shortcode: ":chispas:", Keywords: []string{"chispas", "brillo", "estrellas"}},
+	"zap":      {Name: "alto voltaje", Shortcode: ":alto_voltaje:", Keywords: []string{"electricidad", "voltaje", "rayo", "peligro"}},
+	"gem":      {Name: "piedra preciosa", Shortcode: ":gema:", Keywords: []string{"diamante", "gema", "joya"}},
+	"bomb":     {Name: "bomba", Shortcode: ":bomba:", Keywords: []string{"bomba", "cómic"}},
+
+	// === NATURE ===
+	"sunflower":              {Name: "girasol", Shortcode: ":girasol:", Keywords: []string{"flor", "girasol", "sol"}},
+	"rose":                   {Name: "rosa", Shortcode: ":rosa:", Keywords: []string{"flor", "rosa"}},
+	"tulip":                  {Name: "tulipán", Shortcode: ":tulipan:", Keywords: []string{"flor", "tulipán"}},
+	"cherry_blossom":         {Name: "flor de cerezo", Shortcode: ":flor_de_cerezo:", Keywords: []string{"cerezo", "flor"}},
+	"blossom":                {Name: "flor", Shortcode: ":flor:", Keywords: []string{"flor"}},
+	"hibiscus":               {Name: "flor de hibisco", Shortcode: ":hibisco:", Keywords: []string{"flor", "hibisco"}},
+	"sun":                    {Name: "sol", Shortcode: ":sol:", Keywords: []string{"sol", "rayos", "soleado"}},
+	"sun_with_face":          {Name: "sol con cara", Shortcode: ":sol_con_cara:", Keywords: []string{"sol", "cara", "brillante"}},
+	"sunrise":                {Name: "amanecer", Shortcode: ":amanecer:", Keywords: []string{"amanecer", "mañana", "sol"}},
+	"sunrise_over_mountains": {Name: "amanecer sobre montañas", Shortcode: ":amanecer_montanas:", Keywords: []string{"amanecer", "montaña", "sol"}},
+	"moon":                   {Name: "luna", Shortcode: ":luna:", Keywords: []string{"luna", "creciente"}},
+	"full_moon":              {Name: "luna llena", Shortcode: ":luna_llena:", Keywords: []string{"luna", "llena"}},
+	"new_moon":               {Name: "luna nueva", Shortcode: ":luna_nueva:", Keywords: []string{"luna", "nueva", "oscuro"}},
+	"partly_sunny":           {Name: "sol detrás de una nube", Shortcode: ":sol_y_nubes:", Keywords: []string{"nube", "sol"}},
+	"cloud":                  {Name: "nube", Shortcode: ":nube:", Keywords: []string{"nube", "tiempo"}},
+	"rain_cloud":             {Name: "nube con lluvia", Shortcode: ":lluvia:", Keywords: []string{"nube", "lluvia"}},
+	"snowman":                {Name: "muñeco de nieve sin nieve", Shortcode: ":muneco_de_nieve:", Keywords: []string{"frío", "nieve", "muñeco"}},
+	"snowflake":              {Name: "copo de nieve", Shortcode: ":copo_de_nieve:", Keywords: []string{"frío", "nieve", "copo"}},
+	"rainbow":                {Name: "arcoíris", Shortcode: ":arcoiris:", Keywords: []string{"arcoíris", "lluvia"}},
+
+	// === EARTH & GEOGRAPHY ===
+	"earth_africa":       {Name: "globo terráqueo mostrando Europa y África", Shortcode: ":tierra_africa:", Keywords: []string{"África", "Europa", "globo", "mundo", "tierra"}},
+	"earth_americas":     {Name: "globo terráqueo mostrando América", Shortcode: ":tierra_america:", Keywords: []string{"América", "globo", "mundo", "tierra"}},
+	"earth_asia":         {Name: "globo terráqueo mostrando Asia y Australia", Shortcode: ":tierra_asia:", Keywords: []string{"Asia", "Australia", "globo", "mundo", "tierra"}},
+	"desert_island":      {Name: "isla desierta", Shortcode: ":isla_desierta:", Keywords: []string{"desierta", "isla"}},
+	"classical_building": {Name: "edificio clásico", Shortcode: ":edificio_clasico:", Keywords: []string{"clásico", "edificio"}},
+
+	// === WATER & WAVES ===
+	"ocean":       {Name: "ola de mar", Shortcode: ":ola:", Keywords: []string{"mar", "ola", "agua", "océano"}},
+	"droplet":     {Name: "gota", Shortcode: ":gota:", Keywords: []string{"gota", "agua", "sudor"}},
+	"sweat_drops": {Name: "gotas de sudor", Shortcode: ":gotas_de_sudor:", Keywords: []string{"gotas", "sudor", "salpicadura"}},
+
+	// === PLANTS & TREES ===
+	"seedling":         {Name: "plántula", Shortcode: ":planta:", Keywords: []string{"planta", "brote", "joven"}},
+	"herb":             {Name: "hierba", Shortcode: ":hierba:", Keywords: []string{"hierba", "hoja"}},
+	"four_leaf_clover": {Name: "trébol de cuatro hojas", Shortcode: ":trebol:", Keywords: []string{"trébol", "suerte", "cuatro", "hoja"}},
+	"leaves":           {Name: "hojas revoloteando al viento", Shortcode: ":hojas:", Keywords: []string{"hoja", "viento", "revolotear"}},
+	"evergreen_tree":   {Name: "árbol de hoja perenne", Shortcode: ":arbol_perenne:", Keywords: []string{"árbol", "perenne"}},
+	"deciduous_tree":   {Name: "árbol de hoja caduca", Shortcode: ":arbol:", Keywords: []string{"árbol", "caduca"}},
+	"palm_tree":        {Name: "palmera", Shortcode: ":palmera:", Keywords: []string{"palmera", "árbol"}},
+	"cactus":           {Name: "cactus", Shortcode: ":cactus:", Keywords: []string{"cactus", "planta"}},
+
+	// === FOOD ===
+	"apple":      {Name: "manzana roja", Shortcode: ":manzana:", Keywords: []string{"manzana", "fruta", "rojo"}},
+	"banana":     {Name: "plátano", Shortcode: ":platano:", Keywords: []string{"plátano", "fruta", "banana"}},
+	"grapes":     {Name: "uvas", Shortcode: ":uvas:", Keywords: []string{"fruta", "uva", "uvas"}},
+	"strawberry": {Name: "fresa", Shortcode: ":fresa:", Keywords: []string{"fresa", "fruta", "baya"}},
+	"watermelon": {Name: "sandía", Shortcode: ":sandia:", Keywords: []string{"fruta", "sandía"}},
+	"orange":     {Name: "mandarina", Shortcode: ":mandarina:", Keywords: []string{"fruta", "mandarina", "naranja"}},
+	"lemon":      {Name: "limón", Shortcode: ":limon:", Keywords: []string{"cítrico", "fruta", "limón"}},
+	"peach":      {Name: "melocotón", Shortcode: ":melocoton:", Keywords: []string{"fruta", "melocotón"}},
+	"cherries":   {Name: "cerezas", Shortcode: ":cerezas:", Keywords: []string{"cerezas", "fruta", "rojo"}},
+	"pineapple":  {Name: "piña", Shortcode: ":pina:", Keywords: []string{"fruta", "piña"}},
+	"pizza":      {Name: "pizza", Shortcode: ":pizza:", Keywords: []string{"pizza", "porción", "queso"}},
+	"hamburger":  {Name: "hamburguesa", Shortcode: ":hamburguesa:", Keywords: []string{"hamburguesa"}},
+	"hotdog":     {Name: "perrito caliente", Shortcode: ":perrito_caliente:", Keywords: []string{"perrito caliente", "salchicha"}},
+	"taco":       {Name: "taco", Shortcode: ":taco:", Keywords: []string{"mexicano", "taco"}},
+	"burrito":    {Name: "burrito", Shortcode: ":burrito:", Keywords: []string{"burrito", "mexicano"}},
+
+	// === DRINKS ===
+	"coffee":     {Name: "bebida caliente", Shortcode: ":cafe:", Keywords: []string{"bebida", "café", "caliente", "té"}},
+	"tea":        {Name: "taza de té sin asa", Shortcode: ":te:", Keywords: []string{"bebida", "taza", "té"}},
+	"beer":       {Name: "jarra de cerveza", Shortcode: ":cerveza:", Keywords: []string{"bar", "cerveza", "jarra"}},
+	"beers":      {Name: "jarras de cerveza brindando", Shortcode: ":brindis:", Keywords: []string{"bar", "brindis", "cerveza", "jarra"}},
+	"wine_glass": {Name: "copa de vino", Shortcode: ":vino:", Keywords: []string{"bar", "bebida", "copa", "vino"}},
+	"cocktail":   {Name: "copa de cóctel", Shortcode: ":coctel:", Keywords: []string{"bar", "cóctel", "copa"}},
+
+	// === SPORTS & ACTIVITIES ===
+	"soccer":     {Name: "balón de fútbol", Shortcode: ":futbol:", Keywords: []string{"balón", "fútbol"}},
+	"basketball": {Name: "balón de baloncesto", Shortcode: ":baloncesto:", Keywords: []string{"balón", "baloncesto", "canasta"}},
+	"football":   {Name: "balón de fútbol americano", Shortcode: ":futbol_americano:", Keywords: []string{"americano", "balón", "fútbol"}},
+	"tennis":     {Name: "pelota de tenis", Shortcode: ":tenis:", Keywords: []string{"pelota", "raqueta", "tenis"}},
+	"8ball":      {Name: "bola negra de billar", Shortcode: ":billar:", Keywords: []string{"billar", "bola", "ocho", "juego"}},
+	"golf":       {Name: "banderín en hoyo", Shortcode: ":golf:", Keywords: []string{"golf", "hoyo", "banderín"}},
+
+	// === TRANSPORTATION ===
+	"car":        {Name: "coche", Shortcode: ":coche:", Keywords: []string{"automóvil", "coche", "carro"}},
+	"taxi":       {Name: "taxi", Shortcode: ":taxi:", Keywords: []string{"taxi", "vehículo"}},
+	"bus":        {Name: "autobús", Shortcode: ":autobus:", Keywords: []string{"autobús", "vehículo"}},
+	"train":      {Name: "tren", Shortcode: ":tren:", Keywords: []string{"ferrocarril", "tren"}},
+	"airplane":   {Name: "avión", Shortcode: ":avion:", Keywords: []string{"aeroplano", "avión"}},
+	"rocket":     {Name: "cohete", Shortcode: ":cohete:", Keywords: []string{"cohete", "espacio"}},
+	"ship":       {Name: "barco", Shortcode: ":barco:", Keywords: []string{"barco", "pasajeros"}},
+	"bicycle":    {Name: "bicicleta", Shortcode: ":bicicleta:", Keywords: []string{"bicicleta", "bici"}},
+	"scooter":    {Name: "scooter", Shortcode: ":scooter:", Keywords: []string{"scooter", "motor"}},
+	"motorcycle": {Name: "moto", Shortcode: ":moto:", Keywords: []string{"moto", "motocicleta", "carreras"}},
+	"racing_car": {Name: "coche de carreras", Shortcode: ":coche_de_carreras:", Keywords: []string{"carreras", "coche"}},
+
+	// === OBJECTS ===
+	"calendar":           {Name: "calendario", Shortcode: ":calendario:", Keywords: []string{"calendario", "fecha"}},
+	"phone":              {Name: "teléfono móvil", Shortcode: ":movil:", Keywords: []string{"móvil", "teléfono", "celular"}},
+	"computer":           {Name: "ordenador portátil", Shortcode: ":portatil:", Keywords: []string{"ordenador", "portátil", "computadora"}},
+	"desktop_computer":   {Name: "ordenador de sobremesa", Shortcode: ":ordenador:", Keywords: []string{"ordenador", "sobremesa", "computadora"}},
+	"floppy_disk":        {Name: "disquete", Shortcode: ":disquete:", Keywords: []string{"disco", "disquete", "ordenador"}},
+	"keyboard":           {Name: "teclado", Shortcode: ":teclado:", Keywords: []string{"ordenador", "teclado"}},
+	"mouse_three_button": {Name: "ratón de ordenador", Shortcode: ":raton_de_ordenador:", Keywords: []string{"ordenador", "ratón"}},
+	"camera":             {Name: "cámara de fotos", Shortcode: ":camara:", Keywords: []string{"cámara", "foto"}},
+	"camera_flash":       {Name: "cámara con flash", Shortcode: ":camara_con_flash:", Keywords: []string{"cámara", "flash", "foto"}},
+	"tv":                 {Name: "televisión", Shortcode: ":television:", Keywords: []string{"televisión", "tele"}},
+	"radio":              {Name: "radio", Shortcode: ":radio:", Keywords: []string{"radio"}},
+	"headphones":         {Name: "auricular", Shortcode: ":auriculares:", Keywords: []string{"auricular", "auriculares", "música"}},
+	"microphone":         {Name: "micrófono", Shortcode: ":microfono:", Keywords: []string{"karaoke", "micrófono"}},
+	"studio_microphone":  {Name: "micrófono de estudio", Shortcode: ":microfono_de_estudio:", Keywords: []string{"estudio", "micrófono"}},
+	"chair":              {Name: "silla", Shortcode: ":silla:", Keywords: []string{"asiento", "silla"}},
+	"musical_note":       {Name: "nota musical", Shortcode: ":nota_musical:", Keywords: []string{"música", "nota"}},
+	"notes":              {Name: "notas musicales", Shortcode: ":notas_musicales:", Keywords: []string{"música", "notas"}},
+	"guitar":             {Name: "guitarra", Shortcode: ":guitarra:", Keywords: []string{"guitarra", "instrumento", "música"}},
+	"trumpet":            {Name: "trompeta", Shortcode: ":trompeta:", Keywords: []string{"instrumento", "música", "trompeta"}},
+	"saxophone":          {Name: "saxofón", Shortcode: ":saxofon:", Keywords: []string{"instrumento", "música", "saxo", "saxofón"}},
+
+	// === KEYCAPS ===
+	"hash":       {Name: "teclas: #", Shortcode: ":tecla_almohadilla:", Keywords: []string{"tecla", "teclas"}},
+	"asterisk":   {Name: "teclas: *", Shortcode: ":tecla_asterisco:", Keywords: []string{"tecla", "teclas"}},
+	"zero":       {Name: "teclas: 0", Shortcode: ":tecla_cero:", Keywords: []string{"tecla", "teclas"}},
+	"one":        {Name: "teclas: 1", Shortcode: ":tecla_uno:", Keywords: []string{"tecla", "teclas"}},
+	"two":        {Name: "teclas: 2", Shortcode: ":tecla_dos:", Keywords: []string{"tecla", "teclas"}},
+	"three":      {Name: "teclas: 3", Shortcode: ":tecla_tres:", Keywords: []string{"tecla", "teclas"}},
+	"four":       {Name: "teclas: 4", Shortcode: ":tecla_cuatro:", Keywords: []string{"tecla", "teclas"}},
+	"five":       {Name: "teclas: 5", Shortcode: ":tecla_cinco:", Keywords: []string{"tecla", "teclas"}},
+	"six":        {Name: "teclas: 6", Shortcode: ":tecla_seis:", Keywords: []string{"tecla", "teclas"}},
+	"seven":      {Name: "teclas: 7", Shortcode: ":tecla_siete:", Keywords: []string{"tecla", "teclas"}},
+	"eight":      {Name: "teclas: 8", Shortcode: ":tecla_ocho:", Keywords: []string{"tecla", "teclas"}},
+	"nine":       {Name: "teclas: 9", Shortcode: ":tecla_nueve:", Keywords: []string{"tecla", "teclas"}},
+	"keycap_ten": {Name: "teclas: 10", Shortcode: ":tecla_diez:", Keywords: []string{"tecla", "teclas"}},
+
+	// === FLAGS BY CONTINENT ===
+
+	// === NORTH AMERICA ===
+	"flag_us": {Name: "bandera: Estados Unidos", Shortcode: ":bandera_us:", Keywords: []string{"bandera", "Estados Unidos"}},
+
+	// === EUROPE ===
+	"flag_gb":     {Name: "bandera: Reino Unido", Shortcode: ":bandera_gb:", Keywords: []string{"bandera", "Reino Unido"}},
+	"flag_gb_eng": {Name: "bandera: Inglaterra", Shortcode: ":bandera_inglaterra:", Keywords: []string{"bandera", "Inglaterra"}},
+	"flag_gb_sct": {Name: "bandera: Escocia", Shortcode: ":bandera_escocia:", Keywords: []string{"bandera", "Escocia"}},
+	"flag_gb_wls": {Name: "bandera: Gales", Shortcode: ":bandera_gales:", Keywords: []string{"bandera", "Gales"}},
+	"flag_fr":     {Name: "bandera: Francia", Shortcode: ":bandera_fr:", Keywords: []string{"bandera", "Francia"}},
+	"flag_it":     {Name: "bandera: Italia", Shortcode: ":bandera_it:", Keywords: []string{"bandera", "Italia"}},
+	"flag_de":     {Name: "bandera: Alemania", Shortcode: ":bandera_de:", Keywords: []string{"bandera", "Alemania"}},
+	"flag_es":     {Name: "bandera: España", Shortcode: ":bandera_es:", Keywords: []string{"bandera", "España"}},
+
+	// === ASIA ===
+	"flag_jp": {Name: "bandera: Japón", Shortcode: ":bandera_jp:", Keywords: []string{"bandera", "Japón"}},
+	"flag_cn": {Name: "bandera: China", Shortcode: ":bandera_cn:", Keywords: []string{"bandera", "China"}},
+
+	// === SOUTH AMERICA ===
+	"flag_co": {Name: "bandera: Colombia", Shortcode: ":bandera_co:", Keywords: []string{"bandera", "Colombia"}},
+	"flag_ar": {Name: "bandera: Argentina", Shortcode: ":bandera_ar:", Keywords: []string{"bandera", "Argentina"}},
+	"flag_mx": {Name: "bandera: México", Shortcode: ":bandera_mx:", Keywords: []string{"bandera", "México"}},
+	"flag_br": {Name: "bandera: Brasil", Shortcode: ":bandera_br:", Keywords: []string{"bandera", "Brasil"}},
+}
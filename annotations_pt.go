@@ -0,0 +1,332 @@
+package gomoji
+
+// Portuguese CLDR annotations (short names and keywords) and shortcodes, keyed by emoji name
+var emojiAnnotationsPT = map[string]annotation{
+	// === HAPPY FACE EMOJIS ===
+	"grinning":      {Name: "rosto risonho", Shortcode: ":risonho:", Keywords: []string{"rosto", "sorriso", "feliz"}},
+	"grinning_eyes": {Name: "rosto radiante com olhos sorridentes", Shortcode: ":radiante:", Keywords: []string{"rosto", "olhos", "sorriso", "feliz"}},
+	"joy":           {Name: "rosto chorando de rir", Shortcode: ":chorando_de_rir:", Keywords: []string{"rosto", "lágrima", "risada", "rir"}},
+	"smiley":        {Name: "rosto risonho com olhos bem abertos", Shortcode: ":sorriso_grande:", Keywords: []string{"rosto", "boca", "sorriso", "feliz"}},
+	"smile":         {Name: "rosto risonho com olhos sorridentes", Shortcode: ":sorriso:", Keywords: []string{"rosto", "olhos", "sorriso", "feliz"}},
+	"sweat_smile":   {Name: "rosto risonho com gota de suor", Shortcode: ":sorriso_suor:", Keywords: []string{"rosto", "sorriso", "suor", "frio"}},
+	"laughing":      {Name: "rosto risonho com olhos semicerrados", Shortcode: ":rindo:", Keywords: []string{"rosto", "risada", "sorriso", "feliz"}},
+	"wink":          {Name: "rosto piscando", Shortcode: ":piscada:", Keywords: []string{"rosto", "piscar"}},
+	"blush":         {Name: "rosto sorridente com olhos sorridentes", Shortcode: ":corado:", Keywords: []string{"rosto", "olhos", "sorriso", "feliz"}},
+	"yum":           {Name: "rosto saboreando comida", Shortcode: ":delicia:", Keywords: []string{"rosto", "comida", "delicioso", "gostoso"}},
+
+	// === NEUTRAL/COOL FACE EMOJIS ===
+	"sunglasses":           {Name: "rosto sorridente com óculos escuros", Shortcode: ":oculos_escuros:", Keywords: []string{"rosto", "óculos", "sol", "legal"}},
+	"heart_eyes":           {Name: "rosto sorridente com olhos de coração", Shortcode: ":apaixonado:", Keywords: []string{"rosto", "amor", "coração", "olhos"}},
+	"kissing_heart":        {Name: "rosto mandando um beijo", Shortcode: ":beijo_coracao:", Keywords: []string{"rosto", "beijo"}},
+	"kissing":              {Name: "rosto beijando", Shortcode: ":beijo:", Keywords: []string{"rosto", "beijo"}},
+	"kissing_smiling_eyes": {Name: "rosto beijando com olhos sorridentes", Shortcode: ":beijo_sorridente:", Keywords: []string{"rosto", "beijo", "olhos", "sorriso"}},
+	"kissing_closed_eyes":  {Name: "rosto beijando com olhos fechados", Shortcode: ":beijo_olhos_fechados:", Keywords: []string{"rosto", "beijo", "olhos", "fechados"}},
+	"relaxed":              {Name: "rosto sorridente", Shortcode: ":relaxado:", Keywords: []string{"rosto", "sorriso", "contorno"}},
+	"slight_smile":         {Name: "rosto levemente sorridente", Shortcode: ":sorriso_leve:", Keywords: []string{"rosto", "sorriso"}},
+	"upside_down":          {Name: "rosto de cabeça para baixo", Shortcode: ":de_cabeca_para_baixo:", Keywords: []string{"rosto", "cabeça para baixo"}},
+
+	// === THINKING/NEUTRAL FACE EMOJIS ===
+	"thinking":       {Name: "rosto pensativo", Shortcode: ":pensando:", Keywords: []string{"rosto", "pensar"}},
+	"neutral_face":   {Name: "rosto neutro", Shortcode: ":neutro:", Keywords: []string{"rosto", "inexpressivo", "neutro"}},
+	"expressionless": {Name: "rosto sem expressão", Shortcode: ":inexpressivo:", Keywords: []string{"rosto", "inexpressivo", "sem expressão"}},
+	"no_mouth":       {Name: "rosto sem boca", Shortcode: ":sem_boca:", Keywords: []string{"rosto", "boca", "quieto", "silêncio"}},
+
+	// === SAD/CONCERNED FACE EMOJIS ===
+	"confused":          {Name: "rosto confuso", Shortcode: ":confuso:", Keywords: []string{"rosto", "confusão", "confuso"}},
+	"worried":           {Name: "rosto preocupado", Shortcode: ":preocupado:", Keywords: []string{"rosto", "preocupação"}},
+	"slightly_frowning": {Name: "rosto levemente franzido", Shortcode: ":franzido_leve:", Keywords: []string{"rosto", "franzido", "triste"}},
+	"frowning":          {Name: "rosto franzido", Shortcode: ":franzido:", Keywords: []string{"rosto", "franzido", "triste"}},
+	"persevere":         {Name: "rosto perseverante", Shortcode: ":perseverante:", Keywords: []string{"rosto", "perseverança"}},
+	"confounded":        {Name: "rosto perplexo", Shortcode: ":perplexo:", Keywords: []string{"rosto", "perplexo", "frustração"}},
+	"tired_face":        {Name: "rosto cansado", Shortcode: ":cansado:", Keywords: []string{"rosto", "cansaço"}},
+	"weary":             {Name: "rosto exausto", Shortcode: ":exausto:", Keywords: []string{"rosto", "exaustão", "cansaço"}},
+	"cry":               {Name: "rosto chorando", Shortcode: ":chorando:", Keywords: []string{"rosto", "lágrima", "chorar", "triste"}},
+	"sob":               {Name: "rosto chorando aos berros", Shortcode: ":choro:", Keywords: []string{"rosto", "lágrima", "choro", "triste"}},
+
+	// === ANGRY/UPSET FACE EMOJIS ===
+	"angry":   {Name: "rosto zangado", Shortcode: ":zangado:", Keywords: []string{"rosto", "raiva", "zangado"}},
+	"rage":    {Name: "rosto furioso", Shortcode: ":furioso:", Keywords: []string{"rosto", "raiva", "fúria", "vermelho"}},
+	"triumph": {Name: "rosto soltando vapor pelo nariz", Shortcode: ":bufando:", Keywords: []string{"rosto", "triunfo", "bufar"}},
+
+	// === SURPRISED/SHOCKED FACE EMOJIS ===
+	"open_mouth": {Name: "rosto com boca aberta", Shortcode: ":boca_aberta:", Keywords: []string{"rosto", "boca", "aberta", "surpresa"}},
+	"scream":     {Name: "rosto gritando de medo", Shortcode: ":grito:", Keywords: []string{"rosto", "medo", "grito", "pânico"}},
+	"fearful":    {Name: "rosto amedrontado", Shortcode: ":amedrontado:", Keywords: []string{"rosto", "medo", "susto"}},
+	"cold_sweat": {Name: "rosto ansioso com gota de suor", Shortcode: ":suor_frio:", Keywords: []string{"rosto", "ansiedade", "suor", "frio"}},
+	"hushed":     {Name: "rosto surpreso", Shortcode: ":surpreso:", Keywords: []string{"rosto", "surpresa", "espanto"}},
+	"flushed":    {Name: "rosto ruborizado", Shortcode: ":ruborizado:", Keywords: []string{"rosto", "rubor", "vergonha"}},
+
+	// === SICK/UNWELL FACE EMOJIS ===
+	"dizzy_face": {Name: "rosto com olhos cruzados", Shortcode: ":tonto:", Keywords: []string{"rosto", "tontura", "nocaute"}},
+	"mask":       {Name: "rosto com máscara médica", Shortcode: ":mascara:", Keywords: []string{"rosto", "médico", "máscara", "doente"}},
+
+	// === ANIMALS ===
+	"dog":           {Name: "rosto de cachorro", Shortcode: ":cachorro:", Keywords: []string{"rosto", "cachorro", "cão", "estimação"}},
+	"cat":           {Name: "rosto de gato", Shortcode: ":gato:", Keywords: []string{"rosto", "gato", "estimação"}},
+	"mouse":         {Name: "rosto de camundongo", Shortcode: ":camundongo:", Keywords: []string{"rosto", "camundongo", "rato"}},
+	"hamster":       {Name: "hamster", Shortcode: ":hamster:", Keywords: []string{"rosto", "hamster", "estimação"}},
+	"rabbit":        {Name: "rosto de coelho", Shortcode: ":coelho:", Keywords: []string{"rosto", "coelho", "estimação"}},
+	"bear":          {Name: "rosto de urso", Shortcode: ":urso:", Keywords: []string{"rosto", "urso"}},
+	"panda_face":    {Name: "rosto de panda", Shortcode: ":panda:", Keywords: []string{"rosto", "panda"}},
+	"koala":         {Name: "coala", Shortcode: ":coala:", Keywords: []string{"rosto", "coala", "marsupial"}},
+	"tiger":         {Name: "rosto de tigre", Shortcode: ":tigre:", Keywords: []string{"rosto", "tigre"}},
+	"lion_face":     {Name: "rosto de leão", Shortcode: ":leao:", Keywords: []string{"rosto", "leão", "Leão", "zodíaco"}},
+	"cow":           {Name: "rosto de vaca", Shortcode: ":vaca:", Keywords: []string{"rosto", "vaca"}},
+	"pig":           {Name: "rosto de porco", Shortcode: ":porco:", Keywords: []string{"rosto", "porco"}},
+	"pig_nose":      {Name: "focinho de porco", Shortcode: ":focinho:", Keywords: []string{"rosto", "porco", "focinho"}},
+	"frog":          {Name: "rosto de sapo", Shortcode: ":sapo:", Keywords: []string{"rosto", "sapo"}},
+	"octopus":       {Name: "polvo", Shortcode: ":polvo:", Keywords: []string{"polvo"}},
+	"monkey_face":   {Name: "rosto de macaco", Shortcode: ":macaco:", Keywords: []string{"rosto", "macaco"}},
+	"see_no_evil":   {Name: "macaco que não vê nada", Shortcode: ":nao_vejo:", Keywords: []string{"macaco", "olhos", "proibido", "ver"}},
+	"hear_no_evil":  {Name: "macaco que não ouve nada", Shortcode: ":nao_ouco:", Keywords: []string{"macaco", "ouvidos", "proibido", "ouvir"}},
+	"speak_no_evil": {Name: "macaco que não fala nada", Shortcode: ":nao_falo:", Keywords: []string{"macaco", "boca", "proibido", "falar"}},
+
+	// === HANDS AND GESTURES ===
+	"thumbs_up":        {Name: "polegar para cima", Shortcode: ":joinha:", Keywords: []string{"mão", "polegar", "para cima", "curtir"}},
+	"thumbs_down":      {Name: "polegar para baixo", Shortcode: ":polegar_para_baixo:", Keywords: []string{"mão", "polegar", "para baixo"}},
+	"clap":             {Name: "mãos aplaudindo", Shortcode: ":aplausos:", Keywords: []string{"aplauso", "mãos"}},
+	"raised_hands":     {Name: "mãos para cima", Shortcode: ":celebracao:", Keywords: []string{"celebração", "mãos", "viva"}},
+	"open_hands":       {Name: "mãos abertas", Shortcode: ":maos_abertas:", Keywords: []string{"mãos", "abertas"}},
+	"point_up":         {Name: "indicador apontando para cima", Shortcode: ":indicador_para_cima:", Keywords: []string{"dedo", "indicador", "mão", "para cima"}},
+	"point_down":       {Name: "dorso da mão com dedo indicador apontando para baixo", Shortcode: ":indicador_para_baixo:", Keywords: []string{"dedo", "indicador", "mão", "para baixo"}},
+	"point_left":       {Name: "dorso da mão com dedo indicador apontando para a esquerda", Shortcode: ":indicador_esquerda:", Keywords: []string{"dedo", "indicador", "mão", "esquerda"}},
+	"point_right":      {Name: "dorso da mão com dedo indicador apontando para a direita", Shortcode: ":indicador_direita:", Keywords: []string{"dedo", "indicador", "mão", "direita"}},
+	"ok_hand":          {Name: "sinal de ok", Shortcode: ":ok:", Keywords: []string{"mão", "OK"}},
+	"peace":            {Name: "sinal de paz", Shortcode: ":paz:", Keywords: []string{"mão", "vitória", "paz"}},
+	"crossed_fingers":  {Name: "dedos cruzados", Shortcode: ":dedos_cruzados:", Keywords: []string{"dedos", "cruzados", "sorte"}},
+	"metal":            {Name: "sinal de chifres", Shortcode: ":chifres:", Keywords: []string{"chifres", "mão", "rock"}},
+	"call_me":          {Name: "sinal me liga", Shortcode: ":me_liga:", Keywords: []string{"ligar", "mão", "telefone"}},
+	"fist":             {Name: "punho levantado", Shortcode: ":punho_levantado:", Keywords: []string{"punho", "mão", "levantado"}},
+	"punch":            {Name: "soco", Shortcode: ":soco:", Keywords: []string{"punho", "soco", "mão"}},
+	"left_fist":        {Name: "punho esquerdo", Shortcode: ":punho_esquerdo:", Keywords: []string{"punho", "esquerda"}},
+	"right_fist":       {Name: "punho direito", Shortcode: ":punho_direito:", Keywords: []string{"punho", "direita"}},
+	"wave":             {Name: "mão acenando", Shortcode: ":aceno:", Keywords: []string{"mão", "aceno", "oi", "tchau"}},
+	"pray":             {Name: "mãos juntas", Shortcode: ":rezar:", Keywords: []string{"mãos", "oração", "rezar", "obrigado", "por favor"}},
+	"raised_hand":      {Name: "mão levantada", Shortcode: ":mao_levantada:", Keywords: []string{"mão", "levantada", "toca aqui"}},
+	"hand_splayed":     {Name: "mão aberta com os dedos separados", Shortcode: ":mao_aberta:", Keywords: []string{"mão", "dedos", "aberta"}},
+	"vulcan":           {Name: "saudação vulcana", Shortcode: ":saudacao_vulcana:", Keywords: []string{"mão", "spock", "vulcano"}},
+	"love_you_gesture": {Name: "gesto de te amo", Shortcode: ":te_amo:", Keywords: []string{"mão", "te amo"}},
+	"pinching_hand":    {Name: "mão beliscando", Shortcode: ":beliscar:", Keywords: []string{"mão", "beliscar", "pouco"}},
+	"pinched_fingers":  {Name: "dedos unidos", Shortcode: ":dedos_unidos:", Keywords: []string{"dedos", "mão", "gesto", "italiano"}},
+
+	// === PEOPLE ===
+	"woman_teacher":                   {Name: "professora: pele morena", Shortcode: ":professora:", Keywords: []string{"professora", "mulher", "pele morena"}},
+	"woman_teacher_default_skin_tone": {Name: "professora", Shortcode: ":mestra:", Keywords: []string{"professora", "mestra", "mulher"}},
+	"man_teacher":                     {Name: "professor", Shortcode: ":professor:", Keywords: []string{"professor", "mestre", "homem"}},
+	"man_teacher_medium_skin_tone":    {Name: "professor: pele morena", Shortcode: ":professor_pele_morena:", Keywords: []string{"professor", "mestre", "homem", "pele morena"}},
+	"teacher":                         {Name: "docente", Shortcode: ":docente:", Keywords: []string{"docente", "educador", "professor"}},
+	"teacher_medium_skin_tone":        {Name: "docente: pele morena", Shortcode: ":docente_pele_morena:", Keywords: []string{"docente", "educador", "professor", "pele morena"}},
+	"person":                          {Name: "pessoa", Shortcode: ":pessoa:", Keywords: []string{"pessoa", "adulto", "gênero neutro"}},
+	"man":                             {Name: "homem", Shortcode: ":homem:", Keywords: []string{"homem", "adulto"}},
+	"woman":                           {Name: "mulher", Shortcode: ":mulher:", Keywords: []string{"mulher", "adulta"}},
+	"person_red_hair":                 {Name: "pessoa: cabelo vermelho", Shortcode: ":pessoa_cabelo_vermelho:", Keywords: []string{"pessoa", "cabelo vermelho"}},
+	"person_curly_hair":               {Name: "pessoa: cabelo cacheado", Shortcode: ":pessoa_cabelo_cacheado:", Keywords: []string{"pessoa", "cabelo cacheado"}},
+	"person_white_hair":               {Name: "pessoa: cabelo branco", Shortcode: ":pessoa_cabelo_branco:", Keywords: []string{"pessoa", "cabelo branco"}},
+	"person_bald":                     {Name: "pessoa: careca", Shortcode: ":pessoa_careca:", Keywords: []string{"pessoa", "careca"}},
+	"man_red_hair":                    {Name: "homem: cabelo vermelho", Shortcode: ":homem_cabelo_vermelho:", Keywords: []string{"homem", "cabelo vermelho"}},
+	"man_curly_hair":                  {Name: "homem: cabelo cacheado", Shortcode: ":homem_cabelo_cacheado:", Keywords: []string{"homem", "cabelo cacheado"}},
+	"man_white_hair":                  {Name: "homem: cabelo branco", Shortcode: ":homem_cabelo_branco:", Keywords: []string{"homem", "cabelo branco"}},
+	"man_bald":                        {Name: "homem: careca", Shortcode: ":homem_careca:", Keywords: []string{"homem", "careca"}},
+	"woman_red_hair":                  {Name: "mulher: cabelo vermelho", Shortcode: ":mulher_cabelo_vermelho:", Keywords: []string{"mulher", "cabelo vermelho"}},
+	"woman_curly_hair":                {Name: "mulher: cabelo cacheado", Shortcode: ":mulher_cabelo_cacheado:", Keywords: []string{"mulher", "cabelo cacheado"}},
+	"woman_white_hair":                {Name: "mulher: cabelo branco", Shortcode: ":mulher_cabelo_branco:", Keywords: []string{"mulher", "cabelo branco"}},
+	"woman_bald":                      {Name: "mulher: careca", Shortcode: ":mulher_careca:", Keywords: []string{"mulher", "careca"}},
+	"firefighter":                     {Name: "bombeiro", Shortcode: ":bombeiro_pessoa:", Keywords: []string{"bombeiro", "fogo"}},
+	"man_firefighter":                 {Name: "bombeiro homem", Shortcode: ":bombeiro:", Keywords: []string{"bombeiro", "fogo", "homem"}},
+	"woman_firefighter":               {Name: "bombeira", Shortcode: ":bombeira:", Keywords: []string{"bombeiro", "fogo", "mulher"}},
+	"health_worker":                   {Name: "profissional de saúde", Shortcode: ":profissional_de_saude:", Keywords: []string{"médico", "enfermeiro", "saúde"}},
+	"man_health_worker":               {Name: "homem profissional da saúde", Shortcode: ":profissional_de_saude_homem:", Keywords: []string{"médico", "enfermeiro", "saúde", "homem"}},
+	"woman_health_worker":             {Name: "mulher profissional da saúde", Shortcode: ":profissional_de_saude_mulher:", Keywords: []string{"médico", "enfermeiro", "saúde", "mulher"}},
+	"cook":                            {Name: "chef de cozinha", Shortcode: ":chef_de_cozinha:", Keywords: []string{"chef", "cozinha"}},
+	"man_cook":                        {Name: "cozinheiro", Shortcode: ":cozinheiro:", Keywords: []string{"chef", "cozinha", "homem"}},
+	"woman_cook":                      {Name: "cozinheira", Shortcode: ":cozinheira:", Keywords: []string{"chef", "cozinha", "mulher"}},
+	"technologist":                    {Name: "tecnólogo", Shortcode: ":tecnologo_pessoa:", Keywords: []string{"programador", "tecnologia", "software"}},
+	"man_technologist":                {Name: "tecnólogo homem", Shortcode: ":tecnologo:", Keywords: []string{"programador", "tecnologia", "software", "homem"}},
+	"woman_technologist":              {Name: "tecnóloga", Shortcode: ":tecnologa:", Keywords: []string{"programador", "tecnologia", "software", "mulher"}},
+	"astronaut":                       {Name: "astronauta", Shortcode: ":astronauta:", Keywords: []string{"astronauta", "foguete", "espaço"}},
+	"man_astronaut":                   {Name: "astronauta homem", Shortcode: ":astronauta_homem:", Keywords: []string{"astronauta", "foguete", "espaço", "homem"}},
+	"woman_astronaut":                 {Name: "astronauta mulher", Shortcode: ":astronauta_mulher:", Keywords: []string{"astronauta", "foguete", "espaço", "mulher"}},
+	"person_shrugging":                {Name: "pessoa dando de ombros", Shortcode: ":dar_de_ombros:", Keywords: []string{"dúvida", "indiferença", "ombros"}},
+	"man_shrugging":                   {Name: "homem dando de ombros", Shortcode: ":homem_dando_de_ombros:", Keywords: []string{"dúvida", "indiferença", "ombros", "homem"}},
+	"woman_shrugging":                 {Name: "mulher dando de ombros", Shortcode: ":mulher_dando_de_ombros:", Keywords: []string{"dúvida", "indiferença", "ombros", "mulher"}},
+	"person_facepalming":              {Name: "pessoa decepcionada", Shortcode: ":decepcao:", Keywords: []string{"exasperação", "descrença", "mão"}},
+	"man_facepalming":                 {Name: "homem decepcionado", Shortcode: ":homem_decepcionado:", Keywords: []string{"exasperação", "descrença", "mão", "homem"}},
+	"woman_facepalming":               {Name: "mulher decepcionada", Shortcode: ":mulher_decepcionada:", Keywords: []string{"exasperação", "descrença", "mão", "mulher"}},
+
+	// === PEOPLE ===
+	"eyes": {Name: "olhos", Shortcode: ":olhos:", Keywords: []string{"rosto", "olhos", "olhar"}},
+
+	// === HEARTS ===
+	"heart":           {Name: "coração vermelho", Shortcode: ":coracao:", Keywords: []string{"coração", "amor", "vermelho"}},
+	"yellow_heart":    {Name: "coração amarelo", Shortcode: ":coracao_amarelo:", Keywords: []string{"coração", "amarelo"}},
+	"green_heart":     {Name: "coração verde", Shortcode: ":coracao_verde:", Keywords: []string{"coração", "verde"}},
+	"blue_heart":      {Name: "coração azul", Shortcode: ":coracao_azul:", Keywords: []string{"coração", "azul"}},
+	"purple_heart":    {Name: "coração roxo", Shortcode: ":coracao_roxo:", Keywords: []string{"coração", "roxo"}},
+	"black_heart":     {Name: "coração preto", Shortcode: ":coracao_preto:", Keywords: []string{"coração", "preto", "maldade"}},
+	"broken_heart":    {Name: "coração partido", Shortcode: ":coracao_partido:", Keywords: []string{"coração", "partido", "término"}},
+	"two_hearts":      {Name: "dois corações", Shortcode: ":dois_coracoes:", Keywords: []string{"amor", "corações"}},
+	"sparkling_heart": {Name: "coração brilhante", Shortcode: ":coracao_brilhante:", Keywords: []string{"coração", "brilhante", "animado"}},
+	"heartpulse":      {Name: "coração crescendo", Shortcode: ":coracao_crescendo:", Keywords: []string{"coração", "crescendo", "pulsação"}},
+	"cupid":           {Name: "coração com flecha", Shortcode: ":cupido:", Keywords: []string{"coração", "flecha", "cupido"}},
+
+	// === SYMBOLS ===
+	"star":     {Name: "estrela", Shortcode: ":estrela:", Keywords: []string{"estrela"}},
+	"star2":    {Name: "estrela brilhante", Shortcode: ":estrela_brilhante:", Keywords: []string{"estrela", "brilhante", "brilho"}},
+	"fire":     {Name: "fogo", Shortcode: ":fogo:", Keywords: []string{"fogo", "chama"}},
+	"boom":     {Name: "colisão", Shortcode: ":explosao:", Keywords: []string{"colisão", "explosão", "quadrinhos"}},
+	"sparkles": {Name: "brilhos", Shortcode: ":brilhos:", Keywords: []string{"brilhos", "brilho", "estrelas"}},
+	"zap":      {Name: "alta tensão", Shortcode: ":alta_tensao:", Keywords: []string{"eletricidade", "tensão", "raio", "perigo"}},
+	"gem":      {Name: "pedra preciosa", Shortcode: ":joia:", Keywords: []string{"diamante", "pedra preciosa", "joia"}},
+	"bomb":     {Name: "bomba", Shortcode: ":bomba:", Keywords: []string{"bomba", "quadrinhos"}},
+
+	// === NATURE ===
+	"sunflower":              {Name: "girassol", Shortcode: ":girassol:", Keywords: []string{"flor", "girassol", "sol"}},
+	"rose":                   {Name: "rosa", Shortcode: ":rosa:", Keywords: []string{"flor", "rosa"}},
+	"tulip":                  {Name: "tulipa", Shortcode: ":tulipa:", Keywords: []string{"flor", "tulipa"}},
+	"cherry_blossom":         {Name: "flor de cerejeira", Shortcode: ":flor_de_cerejeira:", Keywords: []string{"cerejeira", "flor"}},
+	"blossom":                {Name: "flor", Shortcode: ":flor:", Keywords: []string{"flor"}},
+	"hibiscus":               {Name: "hibisco", Shortcode: ":hibisco:", Keywords: []string{"flor", "hibisco"}},
+	"sun":                    {Name: "sol", Shortcode: ":sol:", Keywords: []string{"sol", "raios", "ensolarado"}},
+	"sun_with_face":          {Name: "sol com rosto", Shortcode: ":sol_com_rosto:", Keywords: []string{"sol", "rosto", "brilhante"}},
+	"sunrise":                {Name: "nascer do sol", Shortcode: ":nascer_do_sol:", Keywords: []string{"nascer do sol", "manhã", "sol"}},
+	"sunrise_over_mountains": {Name: "nascer do sol nas montanhas", Shortcode: ":nascer_do_sol_montanhas:", Keywords: []string{"nascer do sol", "montanha", "sol"}},
+	"moon":                   {Name: "lua crescente", Shortcode: ":lua:", Keywords: []string{"lua", "crescente"}},
+	"full_moon":              {Name: "lua cheia", Shortcode: ":lua_cheia:", Keywords: []string{"lua", "cheia"}},
+	"new_moon":               {Name: "lua nova", Shortcode: ":lua_nova:", Keywords: []string{"lua", "nova", "escuro"}},
+	"partly_sunny":           {Name: "sol atrás de nuvem", Shortcode: ":sol_e_nuvens:", Keywords: []string{"nuvem", "sol"}},
+	"cloud":                  {Name: "nuvem", Shortcode: ":nuvem:", Keywords: []string{"nuvem", "tempo"}},
+	"rain_cloud":             {Name: "nuvem com chuva", Shortcode: ":chuva:", Keywords: []string{"nuvem", "chuva"}},
+	"snowman":                {Name: "boneco de neve sem neve", Shortcode: ":boneco_de_neve:", Keywords: []string{"frio", "neve", "boneco"}},
+	"snowflake":              {Name: "floco de neve", Shortcode: ":floco_de_neve:", Keywords: []string{"frio", "neve", "floco"}},
+	"rainbow":                {Name: "arco-íris", Shortcode: ":arco_iris:", Keywords: []string{"arco-íris", "chuva"}},
+
+	// === EARTH & GEOGRAPHY ===
+	"earth_africa":       {Name: "globo mostrando Europa e África", Shortcode: ":terra_africa:", Keywords: []string{"África", "Europa", "globo", "mundo", "terra"}},
+	"earth_americas":     {Name: "globo mostrando as Américas", Shortcode: ":terra_americas:", Keywords: []string{"Américas", "globo", "mundo", "terra"}},
+	"earth_asia":         {Name: "globo mostrando Ásia e Oceania", Shortcode: ":terra_asia:", Keywords: []string{"Ásia", "Oceania", "globo", "mundo", "terra"}},
+	"desert_island":      {Name: "ilha deserta", Shortcode: ":ilha_deserta:", Keywords: []string{"deserta", "ilha"}},
+	"classical_building": {Name: "prédio grego", Shortcode: ":predio_grego:", Keywords: []string{"clássico", "prédio", "grego"}},
+
+	// === WATER & WAVES ===
+	"ocean":       {Name: "onda", Shortcode: ":onda:", Keywords: []string{"mar", "onda", "água", "oceano"}},
+	"droplet":     {Name: "gota", Shortcode: ":gota:", Keywords: []string{"gota", "água", "suor"}},
+	"sweat_drops": {Name: "gotas de suor", Shortcode: ":gotas_de_suor:", Keywords: []string{"gotas", "suor", "respingo"}},
+
+	// === PLANTS & TREES ===
+	"seedling":         {Name: "muda", Shortcode: ":muda:", Keywords: []string{"planta", "broto", "muda"}},
+	"herb":             {Name: "erva", Shortcode: ":erva:", Keywords: []string{"erva", "folha"}},
+	"four_leaf_clover": {Name: "trevo de quatro folhas", Shortcode: ":trevo:", Keywords: []string{"trevo", "sorte", "quatro", "folha"}},
+	"leaves":           {Name: "folhas ao vento", Shortcode: ":folhas:", Keywords: []string{"folha", "vento", "esvoaçar"}},
+	"evergreen_tree":   {Name: "conífera", Shortcode: ":conifera:", Keywords: []string{"árvore", "conífera", "pinheiro"}},
+	"deciduous_tree":   {Name: "árvore caidiça", Shortcode: ":arvore:", Keywords: []string{"árvore", "caidiça"}},
+	"palm_tree":        {Name: "palmeira", Shortcode: ":palmeira:", Keywords: []string{"palmeira", "árvore"}},
+	"cactus":           {Name: "cacto", Shortcode: ":cacto:", Keywords: []string{"cacto", "planta"}},
+
+	// === FOOD ===
+	"apple":      {Name: "maçã vermelha", Shortcode: ":maca:", Keywords: []string{"maçã", "fruta", "vermelho"}},
+	"banana":     {Name: "banana", Shortcode: ":banana:", Keywords: []string{"banana", "fruta"}},
+	"grapes":     {Name: "uvas", Shortcode: ":uvas:", Keywords: []string{"fruta", "uva", "uvas"}},
+	"strawberry": {Name: "morango", Shortcode: ":morango:", Keywords: []string{"morango", "fruta"}},
+	"watermelon": {Name: "melancia", Shortcode: ":melancia:", Keywords: []string{"fruta", "melancia"}},
+	"orange":     {Name: "tangerina", Shortcode: ":tangerina:", Keywords: []string{"fruta", "tangerina", "laranja"}},
+	"lemon":      {Name: "limão", Shortcode: ":limao:", Keywords: []string{"cítrico", "fruta", "limão"}},
+	"peach":      {Name: "pêssego", Shortcode: ":pessego:", Keywords: []string{"fruta", "pêssego"}},
+	"cherries":   {Name: "cereja", Shortcode: ":cereja:", Keywords: []string{"cereja", "fruta", "vermelho"}},
+	"pineapple":  {Name: "abacaxi", Shortcode: ":abacaxi:", Keywords: []string{"fruta", "abacaxi"}},
+	"pizza":      {Name: "pizza", Shortcode: ":pizza:", Keywords: []string{"pizza", "fatia", "queijo"}},
+	"hamburger":  {Name: "hambúrguer", Shortcode: ":hamburguer:", Keywords: []string{"hambúrguer"}},
+	"hotdog":     {Name: "cachorro-quente", Shortcode: ":cachorro_quente:", Keywords: []string{"cachorro-quente", "salsicha"}},
+	"taco":       {Name: "taco", Shortcode: ":taco:", Keywords: []string{"mexicano", "taco"}},
+	"burrito":    {Name: "burrito", Shortcode: ":burrito:", Keywords: []string{"burrito", "mexicano"}},
+
+	// === DRINKS ===
+	"coffee":     {Name: "café", Shortcode: ":cafe:", Keywords: []string{"bebida", "café", "quente", "chá"}},
+	"tea":        {Name: "xícara de chá sem alça", Shortcode: ":cha:", Keywords: []string{"bebida", "xícara", "chá"}},
+	"beer":       {Name: "caneca de cerveja", Shortcode: ":cerveja:", Keywords: []string{"bar", "cerveja", "caneca"}},
+	"beers":      {Name: "canecas de cerveja", Shortcode: ":brinde:", Keywords: []string{"bar", "brinde", "cerveja", "caneca"}},
+	"wine_glass": {Name: "taça de vinho", Shortcode: ":vinho:", Keywords: []string{"bar", "bebida", "taça", "vinho"}},
+	"cocktail":   {Name: "coquetel", Shortcode: ":coquetel:", Keywords: []string{"bar", "coquetel", "taça"}},
+
+	// === SPORTS & ACTIVITIES ===
+	"soccer":     {Name: "bola de futebol", Shortcode: ":futebol:", Keywords: []string{"bola", "futebol"}},
+	"basketball": {Name: "bola de basquete", Shortcode: ":basquete:", Keywords: []string{"bola", "basquete", "cesta"}},
+	"football":   {Name: "bola de futebol americano", Shortcode: ":futebol_americano:", Keywords: []string{"americano", "bola", "futebol"}},
+	"tennis":     {Name: "bola de tênis", Shortcode: ":tenis:", Keywords: []string{"bola", "raquete", "tênis"}},
+	"8ball":      {Name: "bola de bilhar", Shortcode: ":bilhar:", Keywords: []string{"bilhar", "bola", "oito", "jogo"}},
+	"golf":       {Name: "bandeira no buraco", Shortcode: ":golfe:", Keywords: []string{"golfe", "buraco", "bandeira"}},
+
+	// === TRANSPORTATION ===
+	"car":        {Name: "carro", Shortcode: ":carro:", Keywords: []string{"automóvel", "carro"}},
+	"taxi":       {Name: "táxi", Shortcode: ":taxi:", Keywords: []string{"táxi", "veículo"}},
+	"bus":        {Name: "ônibus", Shortcode: ":onibus:", Keywords: []string{"ônibus", "veículo"}},
+	"train":      {Name: "trem", Shortcode: ":trem:", Keywords: []string{"ferrovia", "trem"}},
+	"airplane":   {Name: "avião", Shortcode: ":aviao:", Keywords: []string{"aeronave", "avião"}},
+	"rocket":     {Name: "foguete", Shortcode: ":foguete:", Keywords: []string{"foguete", "espaço"}},
+	"ship":       {Name: "navio", Shortcode: ":navio:", Keywords: []string{"navio", "barco", "passageiros"}},
+	"bicycle":    {Name: "bicicleta", Shortcode: ":bicicleta:", Keywords: []string{"bicicleta", "bike"}},
+	"scooter":    {Name: "scooter", Shortcode: ":scooter:", Keywords: []string{"scooter", "motoneta"}},
+	"motorcycle": {Name: "motocicleta", Shortcode: ":moto:", Keywords: []string{"moto", "motocicleta", "corrida"}},
+	"racing_car": {Name: "carro de corrida", Shortcode: ":carro_de_corrida:", Keywords: []string{"corrida", "carro"}},
+
+	// === OBJECTS ===
+	"calendar":           {Name: "calendário", Shortcode: ":calendario:", Keywords: []string{"calendário", "data"}},
+	"phone":              {Name: "telefone celular", Shortcode: ":celular:", Keywords: []string{"celular", "telefone"}},
+	"computer":           {Name: "laptop", Shortcode: ":laptop:", Keywords: []string{"computador", "laptop", "notebook"}},
+	"desktop_computer":   {Name: "computador de mesa", Shortcode: ":computador:", Keywords: []string{"computador", "desktop"}},
+	"floppy_disk":        {Name: "disquete", Shortcode: ":disquete:", Keywords: []string{"disco", "disquete", "computador"}},
+	"keyboard":           {Name: "teclado", Shortcode: ":teclado:", Keywords: []string{"computador", "teclado"}},
+	"mouse_three_button": {Name: "mouse", Shortcode: ":mouse_de_computador:", Keywords: []string{"computador", "mouse"}},
+	"camera":             {Name: "câmera", Shortcode: ":camera:", Keywords: []string{"câmera", "foto"}},
+	"camera_flash":       {Name: "câmera com flash", Shortcode: ":camera_com_flash:", Keywords: []string{"câmera", "flash", "foto"}},
+	"tv":                 {Name: "televisão", Shortcode: ":televisao:", Keywords: []string{"televisão", "tv"}},
+	"radio":              {Name: "rádio", Shortcode: ":radio:", Keywords: []string{"rádio"}},
+	"headphones":         {Name: "fones de ouvido", Shortcode: ":fones_de_ouvido:", Keywords: []string{"fone", "fones de ouvido", "música"}},
+	"microphone":         {Name: "microfone", Shortcode: ":microfone:", Keywords: []string{"karaokê", "microfone"}},
+	"studio_microphone":  {Name: "microfone de estúdio", Shortcode: ":microfone_de_estudio:", Keywords: []string{"estúdio", "microfone"}},
+	"chair":              {Name: "cadeira", Shortcode: ":cadeira:", Keywords: []string{"assento", "cadeira"}},
+	"musical_note":       {Name: "nota musical", Shortcode: ":nota_musical:", Keywords: []string{"música", "nota"}},
+	"notes":              {Name: "notas musicais", Shortcode: ":notas_musicais:", Keywords: []string{"música", "notas"}},
+	"guitar":             {Name: "guitarra", Shortcode: ":guitarra:", Keywords: []string{"guitarra", "instrumento", "música"}},
+	"trumpet":            {Name: "trompete", Shortcode: ":trompete:", Keywords: []string{"instrumento", "música", "trompete"}},
+	"saxophone":          {Name: "saxofone", Shortcode: ":saxofone:", Keywords: []string{"instrumento", "música", "sax", "saxofone"}},
+
+	// === KEYCAPS ===
+	"hash":       {Name: "tecla: #", Shortcode: ":tecla_cerquilha:", Keywords: []string{"tecla"}},
+	"asterisk":   {Name: "tecla: *", Shortcode: ":tecla_asterisco:", Keywords: []string{"tecla"}},
+	"zero":       {Name: "tecla: 0", Shortcode: ":tecla_zero:", Keywords: []string{"tecla"}},
+	"one":        {Name: "tecla: 1", Shortcode: ":tecla_um:", Keywords: []string{"tecla"}},
+	"two":        {Name: "tecla: 2", Shortcode: ":tecla_dois:", Keywords: []string{"tecla"}},
+	"three":      {Name: "tecla: 3", Shortcode: ":tecla_tres:", Keywords: []string{"tecla"}},
+	"four":       {Name: "tecla: 4", Shortcode: ":tecla_quatro:", Keywords: []string{"tecla"}},
+	"five":       {Name: "tecla: 5", Shortcode: ":tecla_cinco:", Keywords: []string{"tecla"}},
+	"six":        {Name: "tecla: 6", Shortcode: ":tecla_seis:", Keywords: []string{"tecla"}},
+	"seven":      {Name: "tecla: 7", Shortcode: ":tecla_sete:", Keywords: []string{"tecla"}},
+	"eight":      {Name: "tecla: 8", Shortcode: ":tecla_oito:", Keywords: []string{"tecla"}},
+	"nine":       {Name: "tecla: 9", Shortcode: ":tecla_nove:", Keywords: []string{"tecla"}},
+	"keycap_ten": {Name: "tecla: 10", Shortcode: ":tecla_dez:", Keywords: []string{"tecla"}},
+
+	// === FLAGS BY CONTINENT ===
+
+	// === NORTH AMERICA ===
+	"flag_us": {Name: "bandeira: Estados Unidos", Shortcode: ":bandeira_us:", Keywords: []string{"bandeira", "Estados Unidos"}},
+
+	// === EUROPE ===
+	"flag_gb":     {Name: "bandeira: Reino Unido", Shortcode: ":bandeira_gb:", Keywords: []string{"bandeira", "Reino Unido"}},
+	"flag_gb_eng": {Name: "bandeira: Inglaterra", Shortcode: ":bandeira_inglaterra:", Keywords: []string{"bandeira", "Inglaterra"}},
+	"flag_gb_sct": {Name: "bandeira: Escócia", Shortcode: ":bandeira_escocia:", Keywords: []string{"bandeira", "Escócia"}},
+	"flag_gb_wls": {Name: "bandeira: País de Gales", Shortcode: ":bandeira_pais_de_gales:", Keywords: []string{"bandeira", "País de Gales"}},
+	"flag_fr":     {Name: "bandeira: França", Shortcode: ":bandeira_fr:", Keywords: []string{"bandeira", "França"}},
+	"flag_it":     {Name: "bandeira: Itália", Shortcode: ":bandeira_it:", Keywords: []string{"bandeira", "Itália"}},
+	"flag_de":     {Name: "bandeira: Alemanha", Shortcode: ":bandeira_de:", Keywords: []string{"bandeira", "Alemanha"}},
+	"flag_es":     {Name: "bandeira: Espanha", Shortcode: ":bandeira_es:", Keywords: []string{"bandeira", "Espanha"}},
+
+	// === ASIA ===
+	"flag_jp": {Name: "bandeira: Japão", Shortcode: ":bandeira_jp:", Keywords: []string{"bandeira", "Japão"}},
+	"flag_cn": {Name: "bandeira: China", Shortcode: ":bandeira_cn:", Keywords: []string{"bandeira", "China"}},
+
+	// === SOUTH AMERICA ===
+	"flag_co": {Name: "bandeira: Colômbia", Shortcode: ":bandeira_co:", Keywords: []string{"bandeira", "Colômbia"}},
+	"flag_ar": {Name: "bandeira: Argentina", Shortcode: ":bandeira_ar:", Keywords: []string{"bandeira", "Argentina"}},
+	"flag_mx": {Name: "bandeira: México", Shortcode: ":bandeira_mx:", Keywords: []string{"bandeira", "México"}},
+	"flag_br": {Name: "bandeira: Brasil", Shortcode: ":bandeira_br:", Keywords: []string{"bandeira", "Brasil"}},
+}
@@ -0,0 +1,279 @@
+package gomoji
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/Santiago-Balcero/gobserve"
+	"go.uber.org/zap"
+)
+
+// FormatCodec encodes and decodes an emoji format. Implement it and call
+// RegisterFormat to add a format without changing gomoji.
+type FormatCodec interface {
+	// Encode returns the representation of an emoji's code points.
+	Encode(codepoints []rune) string
+	// Decode returns the code points that input represents, or false if
+	// input isn't written in the format.
+	Decode(input string) ([]rune, bool)
+	// Scan returns the start and end byte offsets of every candidate emoji
+	// in text, like regexp.Regexp.FindAllStringIndex. TransformText decodes
+	// each span and replaces it when it is a supported emoji.
+	Scan(text string) [][]int
+}
+
+// coreFormats are the formats backed by the emoji table rather than a codec.
+var coreFormats = []Format{FormatEmoji, FormatShortcode, FormatHTML, FormatUnicode, FormatDescription, FormatEmoticon}
+
+// builtinCodecs are the escape formats shipped with gomoji, in the order
+// they are tried when recognizing input.
+var builtinCodecs = []struct {
+	format Format
+	codec  FormatCodec
+}{
+	{FormatJSEscape, escapeCodec{encodeJSEscape, jsEscapeRegex, parseJSEscapes}},
+	{FormatCodepoint, escapeCodec{encodeDefaultCodepoint, codepointRegex, parseCodepoints}},
+	{FormatRust, escapeCodec{encodeRustEscape, rustEscapeRegex, parseRustEscapes}},
+	{FormatCSS, escapeCodec{encodeCSSEscape, cssEscapeRegex, parseCSSEscapes}},
+	{FormatPython, escapeCodec{encodePythonEscape, pythonEscapeRegex, parsePythonEscapes}},
+}
+
+// builtinAliases are built-in formats written exactly like another one. They
+// are valid targets using the codec of the format they alias, which alone
+// recognizes their input.
+var builtinAliases = []struct {
+	format Format
+	alias  Format
+}{
+	{FormatJava, FormatJSEscape},
+}
+
+// Codecs of the built-in escape formats and of formats added with
+// RegisterFormat, in the order they are tried
+var (
+	codecMu      sync.RWMutex
+	formatCodecs map[Format]FormatCodec
+	codecOrder   []Format
+)
+
+func init() {
+	formatCodecs = make(map[Format]FormatCodec)
+	for _, builtin := range builtinCodecs {
+		formatCodecs[builtin.format] = builtin.codec
+		codecOrder = append(codecOrder, builtin.format)
+	}
+	for _, builtin := range builtinAliases {
+		formatCodecs[builtin.format] = formatCodecs[builtin.alias]
+	}
+}
+
+// RegisterFormat adds a format, making it a valid target for Transform and
+// TransformText and recognizing its input everywhere emojis are accepted.
+//
+// Registering a format again replaces its codec. Built-in formats cannot be
+// replaced. Registered formats are tried after the built-in ones, in the
+// order they were registered.
+//
+// Example:
+//
+//	err := RegisterFormat("slack", slackCodec{})
+//	result, _ := Transform("😄", "slack")
+func RegisterFormat(format Format, codec FormatCodec) error {
+	if format == "" {
+		return errors.New("format name cannot be empty")
+	}
+	if codec == nil {
+		return fmt.Errorf("codec for format %s cannot be nil", format)
+	}
+	if isBuiltinFormat(format) {
+		return fmt.Errorf("format %s is built in and cannot be replaced", format)
+	}
+
+	codecMu.Lock()
+	defer codecMu.Unlock()
+	if _, exists := formatCodecs[format]; !exists {
+		codecOrder = append(codecOrder, format)
+	}
+	formatCodecs[format] = codec
+	return nil
+}
+
+// isBuiltinFormat reports whether format is shipped with gomoji.
+func isBuiltinFormat(format Format) bool {
+	for _, core := range coreFormats {
+		if core == format {
+			return true
+		}
+	}
+	for _, builtin := range builtinCodecs {
+		if builtin.format == format {
+			return true
+		}
+	}
+	for _, builtin := range builtinAliases {
+		if builtin.format == format {
+			return true
+		}
+	}
+	return false
+}
+
+// lookupCodec returns the codec of a built-in escape or registered format.
+func lookupCodec(format Format) (FormatCodec, bool) {
+	codecMu.RLock()
+	defer codecMu.RUnlock()
+	codec, exists := formatCodecs[format]
+	return codec, exists
+}
+
+// textOptInFormats are the escape formats only scanned for in text when
+// Options.Escapes is set, since a backslash followed by hex digits, as in a
+// Windows path, is common in plain text.
+var textOptInFormats = []Format{FormatRust, FormatCSS, FormatPython}
+
+// registeredCodecs returns every codec in the order they are tried.
+func registeredCodecs() []FormatCodec {
+	return textCodecs(true)
+}
+
+// textCodecs returns the codecs scanned for in text, in the order they are
+// tried. The formats in textOptInFormats are left out unless escapes is set.
+func textCodecs(escapes bool) []FormatCodec {
+	codecMu.RLock()
+	defer codecMu.RUnlock()
+	codecs := make([]FormatCodec, 0, len(codecOrder))
+	for _, format := range codecOrder {
+		if !escapes && slices.Contains(textOptInFormats, format) {
+			continue
+		}
+		codecs = append(codecs, formatCodecs[format])
+	}
+	return codecs
+}
+
+// validFormats lists every valid target format for error messages.
+func validFormats() string {
+	names := make([]string, 0, len(coreFormats)+len(builtinAliases)+len(codecOrder))
+	for _, format := range coreFormats {
+		names = append(names, string(format))
+	}
+
+	codecMu.RLock()
+	defer codecMu.RUnlock()
+	for _, format := range codecOrder[:len(builtinCodecs)] {
+		names = append(names, string(format))
+	}
+	for _, builtin := range builtinAliases {
+		names = append(names, string(builtin.format))
+	}
+	for _, format := range codecOrder[len(builtinCodecs):] {
+		names = append(names, string(format))
+	}
+	return strings.Join(names, ", ")
+}
+
+// decodeWithCodecs resolves input written in any codec's format to an emoji
+// name.
+func decodeWithCodecs(input string) (string, bool) {
+	for _, codec := range registeredCodecs() {
+		codepoints, ok := codec.Decode(input)
+		if !ok {
+			continue
+		}
+		if name, exists := lookupEmoji(string(codepoints)); exists {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+// replaceWithCodec replaces every emoji found in text by codec's scanner with
+// the result of replace for the emoji's name.
+func replaceWithCodec(ctx context.Context, text string, codec FormatCodec, replace func(name string) (string, error)) []segment {
+	if escapes, ok := codec.(escapeCodec); ok {
+		return escapes.replace(ctx, text, replace)
+	}
+	return replaceScanned(ctx, text, codec, replace)
+}
+
+// replaceScanned replaces every span found by codec's scanner that decodes to
+// a supported emoji. Other spans are kept as they were written.
+func replaceScanned(ctx context.Context, text string, codec FormatCodec, replace func(name string) (string, error)) []segment {
+	spans := codec.Scan(text)
+	sort.Slice(spans, func(i, j int) bool { return spans[i][0] < spans[j][0] })
+
+	var b segmentBuilder
+	last := 0
+	for _, span := range spans {
+		start, end := span[0], span[1]
+		if start < last || end > len(text) || start >= end {
+			continue // Skip overlapping or invalid spans
+		}
+
+		match := text[start:end]
+		codepoints, ok := codec.Decode(match)
+		if !ok {
+			continue
+		}
+		name, exists := lookupEmoji(string(codepoints))
+		if !exists {
+			continue
+		}
+		transformed, err := replace(name)
+		if err != nil {
+			gobserve.AddLogFields(
+				ctx,
+				zap.Error(fmt.Errorf("transformation for %q with name %q failed: %w", match, name, err)),
+			)
+			continue
+		}
+
+		b.keep(text[last:start])
+		b.replace(transformed)
+		last = end
+	}
+	b.keep(text[last:])
+	return b.segments
+}
+
+// escapeCodec is the codec of the built-in escape formats. Runs of escapes
+// may hold several emojis, so text is replaced by greedy matching instead of
+// by decoding whole spans.
+type escapeCodec struct {
+	encode func(s string) string
+	re     *regexp.Regexp
+	parse  func(run string) []escapedRune
+}
+
+func (c escapeCodec) Encode(codepoints []rune) string {
+	return c.encode(string(codepoints))
+}
+
+func (c escapeCodec) Decode(input string) ([]rune, bool) {
+	return decodeRunes(input, c.re, c.parse)
+}
+
+func (c escapeCodec) Scan(text string) [][]int {
+	return c.re.FindAllStringIndex(text, -1)
+}
+
+// replace replaces every escaped emoji in text with the result of replace for
+// the emoji's name. Invalid escapes and unknown characters are kept as they
+// were written.
+func (c escapeCodec) replace(ctx context.Context, text string, replace func(name string) (string, error)) []segment {
+	var b segmentBuilder
+	last := 0
+	for _, run := range c.re.FindAllStringIndex(text, -1) {
+		b.keep(text[last:run[0]])
+		b.add(replaceEscapedRunes(ctx, c.parse(text[run[0]:run[1]]), replace))
+		last = run[1]
+	}
+	b.keep(text[last:])
+	return b.segments
+}
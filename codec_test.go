@@ -0,0 +1,124 @@
+package gomoji
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// markupCodec is a proprietary markup writing emojis as [emoji:1f604-fe0f].
+type markupCodec struct{}
+
+var markupRegex = regexp.MustCompile(`\[emoji:[0-9a-f\-]+\]`)
+
+func (markupCodec) Encode(codepoints []rune) string {
+	parts := make([]string, 0, len(codepoints))
+	for _, r := range codepoints {
+		parts = append(parts, fmt.Sprintf("%x", r))
+	}
+	return "[emoji:" + strings.Join(parts, "-") + "]"
+}
+
+func (markupCodec) Decode(input string) ([]rune, bool) {
+	if !markupRegex.MatchString(input) || markupRegex.FindString(input) != input {
+		return nil, false
+	}
+	var codepoints []rune
+	for _, part := range strings.Split(input[len("[emoji:"):len(input)-1], "-") {
+		value, err := strconv.ParseUint(part, 16, 32)
+		if err != nil {
+			return nil, false
+		}
+		codepoints = append(codepoints, rune(value))
+	}
+	return codepoints, true
+}
+
+func (markupCodec) Scan(text string) [][]int {
+	return markupRegex.FindAllStringIndex(text, -1)
+}
+
+func TestRegisterFormat(t *testing.T) {
+	ctx := context.Background()
+	const formatMarkup Format = "markup"
+
+	if _, err := Transform("😄", formatMarkup); err == nil {
+		t.Fatal("expected error for unregistered format")
+	}
+
+	if err := RegisterFormat(formatMarkup, markupCodec{}); err != nil {
+		t.Fatalf("RegisterFormat returned error: %v", err)
+	}
+
+	result, err := Transform("🎙️", formatMarkup)
+	if err != nil {
+		t.Fatalf("Transform to markup returned error: %v", err)
+	}
+	if result != "[emoji:1f399-fe0f]" {
+		t.Errorf("Transform to markup = %s, expected [emoji:1f399-fe0f]", result)
+	}
+
+	result, err = Transform("[emoji:1f604]", FormatShortcode)
+	if err != nil {
+		t.Fatalf("Transform from markup returned error: %v", err)
+	}
+	if result != ":smile:" {
+		t.Errorf("Transform from markup = %s, expected :smile:", result)
+	}
+
+	text := TransformText(ctx, "Hi [emoji:1f604] and [emoji:41]", FormatEmoji)
+	if text != "Hi 😄 and [emoji:41]" {
+		t.Errorf("TransformText from markup = %q, expected %q", text, "Hi 😄 and [emoji:41]")
+	}
+
+	text = TransformText(ctx, "Hi 😄", formatMarkup)
+	if text != "Hi [emoji:1f604]" {
+		t.Errorf("TransformText to markup = %q, expected %q", text, "Hi [emoji:1f604]")
+	}
+}
+
+func TestRegisterFormatErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		format Format
+		codec  FormatCodec
+	}{
+		{"empty format", "", markupCodec{}},
+		{"nil codec", "nil_codec", nil},
+		{"core format", FormatHTML, markupCodec{}},
+		{"built-in codec", FormatRust, markupCodec{}},
+		{"built-in alias", FormatJava, markupCodec{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := RegisterFormat(tt.format, tt.codec); err == nil {
+				t.Errorf("RegisterFormat(%q) expected error, got nil", tt.format)
+			}
+		})
+	}
+}
+
+func TestBuiltinAliases(t *testing.T) {
+	for _, builtin := range builtinAliases {
+		for _, format := range codecOrder {
+			if format == builtin.format {
+				t.Errorf("alias %s is scanned as its own codec", builtin.format)
+			}
+		}
+		if !strings.Contains(validFormats(), string(builtin.format)) {
+			t.Errorf("validFormats() = %q, missing %s", validFormats(), builtin.format)
+		}
+	}
+
+	result, err := Transform("😄", FormatJava)
+	if err != nil {
+		t.Fatalf("Transform to java returned error: %v", err)
+	}
+	if result != `\uD83D\uDE04` {
+		t.Errorf("Transform to java = %s, expected %s", result, `\uD83D\uDE04`)
+	}
+}
@@ -0,0 +1,80 @@
+package gomoji
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// defaultCodepointSeparator separates the code points of multi-code-point
+// emojis in FormatCodepoint output.
+const defaultCodepointSeparator = " "
+
+// codepointRegex matches runs of U+XXXX code points separated by spaces,
+// commas, hyphens or underscores.
+var codepointRegex = regexp.MustCompile(`(?i)\bU\+[0-9A-F]{4,6}(?:[\s,_\-]*U\+[0-9A-F]{4,6})*`)
+
+// codepointPartRegex matches a single U+XXXX code point.
+var codepointPartRegex = regexp.MustCompile(`(?i)U\+([0-9A-F]{4,6})`)
+
+// TransformCodepoint converts an emoji in any supported format to code-point
+// notation, joining the code points of multi-code-point emojis with separator.
+//
+// Example:
+//
+//	codepoints, err := TransformCodepoint("🎙️", "-")
+//	// codepoints: "U+1F399-U+FE0F"
+func TransformCodepoint(input, separator string) (string, error) {
+	emoji, err := Transform(input, FormatEmoji)
+	if err != nil {
+		return "", err
+	}
+	return encodeCodepoint(emoji, separator), nil
+}
+
+// encodeDefaultCodepoint encodes s as U+XXXX code points joined by the
+// default separator.
+func encodeDefaultCodepoint(s string) string {
+	return encodeCodepoint(s, defaultCodepointSeparator)
+}
+
+// encodeCodepoint encodes s as U+XXXX code points joined by separator.
+func encodeCodepoint(s, separator string) string {
+	parts := make([]string, 0, utf8.RuneCountInString(s))
+	for _, r := range s {
+		parts = append(parts, fmt.Sprintf("U+%04X", r))
+	}
+	return strings.Join(parts, separator)
+}
+
+// parseCodepoints decodes a run of U+XXXX code points, keeping the separator
+// that follows each one.
+func parseCodepoints(run string) []escapedRune {
+	return parseHexEscapes(run, codepointPartRegex)
+}
+
+// encodeHTML encodes s as hexadecimal HTML character references.
+func encodeHTML(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		fmt.Fprintf(&b, "&#x%x;", r)
+	}
+	return b.String()
+}
+
+// encodeUnicode encodes s as Go-style unicode escapes. Variation selectors,
+// joiners and other combining or format characters use the short \uXXXX
+// form; every other code point uses \UXXXXXXXX.
+func encodeUnicode(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		if r <= 0xFFFF && unicode.In(r, unicode.Mn, unicode.Me, unicode.Cf) {
+			fmt.Fprintf(&b, "\\u%04X", r)
+			continue
+		}
+		fmt.Fprintf(&b, "\\U%08X", r)
+	}
+	return b.String()
+}
@@ -0,0 +1,172 @@
+package gomoji
+
+import (
+	"context"
+	"testing"
+)
+
+func TestTransformCodepoint(t *testing.T) {
+	tests := []struct {
+		name         string
+		input        string
+		targetFormat Format
+		expected     string
+	}{
+		{"emoji to codepoint", "😄", FormatCodepoint, "U+1F604"},
+		{"sequence to codepoint", "🎙️", FormatCodepoint, "U+1F399 U+FE0F"},
+		{"codepoint to emoji", "U+1F604", FormatEmoji, "😄"},
+		{"lowercase codepoint", "u+1f604", FormatShortcode, ":smile:"},
+		{"sequence to shortcode", "U+1F399 U+FE0F", FormatShortcode, ":studio_microphone:"},
+		{"comma separated", "U+1F399, U+FE0F", FormatEmoji, "🎙️"},
+		{"without variation selector", "U+2764", FormatEmoji, "❤️"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Transform(tt.input, tt.targetFormat)
+			if err != nil {
+				t.Fatalf("Transform(%s) returned error: %v", tt.input, err)
+			}
+			if result != tt.expected {
+				t.Errorf("Transform(%s) = %s, expected %s", tt.input, result, tt.expected)
+			}
+		})
+	}
+
+	result, err := TransformCodepoint(":studio_microphone:", "-")
+	if err != nil {
+		t.Fatalf("TransformCodepoint returned error: %v", err)
+	}
+	if result != "U+1F399-U+FE0F" {
+		t.Errorf("TransformCodepoint = %s, expected U+1F399-U+FE0F", result)
+	}
+
+	for _, input := range []string{"U+D83D", "U+110000", "U+1F604 text"} {
+		if IsSupported(input) {
+			t.Errorf("IsSupported(%s) = true, expected false", input)
+		}
+	}
+}
+
+func TestTransformTextCodepoint(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name         string
+		input        string
+		targetFormat Format
+		opts         Options
+		expected     string
+	}{
+		{
+			name:         "codepoints in text",
+			input:        "Bug with U+1F604 and U+1F399 U+FE0F rendering",
+			targetFormat: FormatEmoji,
+			expected:     "Bug with 😄 and 🎙️ rendering",
+		},
+		{
+			name:         "separate emojis keep their separator",
+			input:        "U+1F604 U+1F525",
+			targetFormat: FormatShortcode,
+			expected:     ":smile: :fire:",
+		},
+		{
+			name:         "unknown codepoints are kept",
+			input:        "U+0041 U+1F604",
+			targetFormat: FormatEmoji,
+			expected:     "U+0041 😄",
+		},
+		{
+			name:         "emoji to codepoint",
+			input:        "Mic 🎙️",
+			targetFormat: FormatCodepoint,
+			expected:     "Mic U+1F399 U+FE0F",
+		},
+		{
+			name:         "custom separator",
+			input:        "Mic 🎙️",
+			targetFormat: FormatCodepoint,
+			opts:         Options{CodepointSeparator: "_"},
+			expected:     "Mic U+1F399_U+FE0F",
+		},
+		{
+			name:         "plus separator",
+			input:        "mic 🎙️ end",
+			targetFormat: FormatCodepoint,
+			opts:         Options{CodepointSeparator: "+"},
+			expected:     "mic U+1F399+U+FE0F end",
+		},
+		{
+			name:         "pipe separator",
+			input:        "mic 🎙️ end",
+			targetFormat: FormatCodepoint,
+			opts:         Options{CodepointSeparator: "|"},
+			expected:     "mic U+1F399|U+FE0F end",
+		},
+		{
+			name:         "slash separator",
+			input:        "mic 🎙️ end",
+			targetFormat: FormatCodepoint,
+			opts:         Options{CodepointSeparator: "/"},
+			expected:     "mic U+1F399/U+FE0F end",
+		},
+		{
+			name:         "separator with fallback",
+			input:        "mic 🎙️ and 👍🏽",
+			targetFormat: FormatCodepoint,
+			opts:         Options{CodepointSeparator: "+", Fallback: true},
+			expected:     "mic U+1F399+U+FE0F and U+1F44D+U+1F3FD",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := TransformTextWithOptions(ctx, tt.input, tt.targetFormat, tt.opts)
+			if result != tt.expected {
+				t.Errorf("TransformTextWithOptions(%q) = %q, expected %q", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestDerivedRepresentations(t *testing.T) {
+	tests := []struct {
+		name            string
+		input           string
+		expectedHTML    string
+		expectedUnicode string
+	}{
+		{"single code point", "smile", "&#x1f604;", `\U0001F604`},
+		{"variation selector", "studio_microphone", "&#x1f399;&#xfe0f;", `\U0001F399\uFE0F`},
+		{"BMP emoji", "heart", "&#x2764;&#xfe0f;", `\U00002764\uFE0F`},
+		{"ZWJ sequence", "woman_teacher", "&#x1f469;&#x1f3fd;&#x200d;&#x1f3eb;", `\U0001F469\U0001F3FD\u200D\U0001F3EB`},
+		{"flag", "flag_es", "&#x1f1ea;&#x1f1f8;", `\U0001F1EA\U0001F1F8`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info, err := GetEmojiInfo(tt.input)
+			if err != nil {
+				t.Fatalf("GetEmojiInfo(%s) returned error: %v", tt.input, err)
+			}
+			if info.HTML != tt.expectedHTML {
+				t.Errorf("HTML = %s, expected %s", info.HTML, tt.expectedHTML)
+			}
+			if info.Unicode != tt.expectedUnicode {
+				t.Errorf("Unicode = %s, expected %s", info.Unicode, tt.expectedUnicode)
+			}
+		})
+	}
+
+	info, err := GetEmojiInfo(":flag_es:")
+	if err != nil {
+		t.Fatalf("GetEmojiInfo(:flag_es:) returned error: %v", err)
+	}
+	if info.Emoji != "🇪🇸" {
+		t.Errorf("flag_es Emoji = %s, expected 🇪🇸", info.Emoji)
+	}
+	codepoints := info.Codepoints()
+	if len(codepoints) != 2 || codepoints[0] != 0x1F1EA || codepoints[1] != 0x1F1F8 {
+		t.Errorf("flag_es Codepoints() = %U, expected [U+1F1EA U+1F1F8]", codepoints)
+	}
+}
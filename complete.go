@@ -0,0 +1,71 @@
+package gomoji
+
+import (
+	"sort"
+	"strings"
+)
+
+// indexEntry is a shortcode or alias (without colons) pointing to an emoji name.
+type indexEntry struct {
+	key  string
+	name string
+}
+
+// Complete returns the emojis whose shortcode or aliases start with prefix.
+//
+// This is meant for shortcode pickers: the prefix may include the leading colon
+// the user typed (":thu"), and results are ordered by popularity and then
+// alphabetically by name, so the same prefix always gives the same order.
+// A limit of zero or less returns every match.
+//
+// Example:
+//
+//	results := Complete(":thu", 2)
+//	// results[0].Shortcode: ":thumbs_up:"
+//	// results[1].Shortcode: ":thumbs_down:"
+func Complete(prefix string, limit int) []Mapping {
+	prefix = strings.Trim(strings.ToLower(strings.TrimSpace(prefix)), ":")
+
+	// Binary search the first key not less than prefix, then walk forward
+	// while keys still start with it
+	start := sort.Search(len(shortcodeIndex), func(i int) bool {
+		return shortcodeIndex[i].key >= prefix
+	})
+
+	var names []string
+	seen := make(map[string]bool)
+	for i := start; i < len(shortcodeIndex) && strings.HasPrefix(shortcodeIndex[i].key, prefix); i++ {
+		name := shortcodeIndex[i].name
+		if !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+
+	sort.Slice(names, func(i, j int) bool {
+		ri, rj := completionRank(names[i]), completionRank(names[j])
+		if ri != rj {
+			return ri < rj
+		}
+		return names[i] < names[j]
+	})
+
+	if limit > 0 && len(names) > limit {
+		names = names[:limit]
+	}
+
+	results := make([]Mapping, 0, len(names))
+	for _, name := range names {
+		results = append(results, mappingOf(name))
+	}
+	return results
+}
+
+// completionRank returns the popularity rank of an emoji name. Emojis without
+// popularity data rank after all others.
+func completionRank(name string) int {
+	if rank, exists := popularityRank[name]; exists {
+		return rank
+	}
+	return len(emojiPopularity)
+}
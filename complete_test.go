@@ -0,0 +1,88 @@
+package gomoji
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestComplete(t *testing.T) {
+	tests := []struct {
+		name     string
+		prefix   string
+		limit    int
+		expected []string
+	}{
+		{
+			name:     "popular first",
+			prefix:   ":thu",
+			limit:    0,
+			expected: []string{":thumbs_up:", ":thumbs_down:"},
+		},
+		{
+			name:     "without colon",
+			prefix:   "thu",
+			limit:    1,
+			expected: []string{":thumbs_up:"},
+		},
+		{
+			name:     "popularity then alphabetical",
+			prefix:   "sun",
+			limit:    0,
+			expected: []string{":sunglasses:", ":sun_with_face:", ":sun:", ":sunflower:", ":sunrise:", ":sunrise_over_mountains:"},
+		},
+		{
+			name:     "alias prefix",
+			prefix:   ":+",
+			limit:    0,
+			expected: []string{":thumbs_up:"},
+		},
+		{
+			name:     "no match",
+			prefix:   ":zzz",
+			limit:    5,
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results := Complete(tt.prefix, tt.limit)
+			var shortcodes []string
+			for _, result := range results {
+				shortcodes = append(shortcodes, result.Shortcode)
+			}
+			if strings.Join(shortcodes, " ") != strings.Join(tt.expected, " ") {
+				t.Errorf("Complete(%q, %d) = %v, expected %v", tt.prefix, tt.limit, shortcodes, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCompleteLimit(t *testing.T) {
+	results := Complete("", 10)
+	if len(results) != 10 {
+		t.Fatalf("Complete(\"\", 10) returned %d results, expected 10", len(results))
+	}
+	if results[0].Shortcode != ":joy:" {
+		t.Errorf("most popular completion = %s, expected :joy:", results[0].Shortcode)
+	}
+}
+
+func TestPopularityNames(t *testing.T) {
+	seen := make(map[string]bool)
+	for _, name := range emojiPopularity {
+		if _, exists := emojiMappings[name]; !exists {
+			t.Errorf("popularity entry %q is not a supported emoji", name)
+		}
+		if seen[name] {
+			t.Errorf("popularity entry %q is listed twice", name)
+		}
+		seen[name] = true
+	}
+}
+
+func BenchmarkComplete(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_ = Complete(":thu", 5)
+	}
+}
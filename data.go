@@ -1,1348 +1,1513 @@
 package gomoji
 
 // Complete emoji mapping database with most common face emojis and essential others
+// HTML and Unicode representations are derived from Emoji when the package is initialized
+// Emoji versions come from the Unicode emoji-test.txt data file (Emoji 15.1)
 var emojiMappings = map[string]Mapping{
 	// === HAPPY FACE EMOJIS ===
 	"grinning": {
-		Emoji:     "😀",
-		Shortcode: ":grinning:",
-		HTML:      "&#x1f600;",
-		Unicode:   "\\U0001F600",
+		Emoji:        "😀",
+		Shortcode:    ":grinning:",
+		EmojiVersion: 1.0,
 	},
 	"grinning_eyes": {
-		Emoji:     "😁",
-		Shortcode: ":grinning_eyes:",
-		HTML:      "&#x1f601;",
-		Unicode:   "\\U0001F601",
+		Emoji:        "😁",
+		Shortcode:    ":grinning_eyes:",
+		EmojiVersion: 0.6,
 	},
 	"joy": {
-		Emoji:     "😂",
-		Shortcode: ":joy:",
-		HTML:      "&#x1f602;",
-		Unicode:   "\\U0001F602",
+		Emoji:        "😂",
+		Shortcode:    ":joy:",
+		EmojiVersion: 0.6,
 	},
 	"smiley": {
-		Emoji:     "😃",
-		Shortcode: ":smiley:",
-		HTML:      "&#x1f603;",
-		Unicode:   "\\U0001F603",
+		Emoji:        "😃",
+		Shortcode:    ":smiley:",
+		EmojiVersion: 0.6,
 	},
 	"smile": {
-		Emoji:     "😄",
-		Shortcode: ":smile:",
-		HTML:      "&#x1f604;",
-		Unicode:   "\\U0001F604",
+		Emoji:        "😄",
+		Shortcode:    ":smile:",
+		EmojiVersion: 0.6,
 	},
 	"sweat_smile": {
-		Emoji:     "😅",
-		Shortcode: ":sweat_smile:",
-		HTML:      "&#x1f605;",
-		Unicode:   "\\U0001F605",
+		Emoji:        "😅",
+		Shortcode:    ":sweat_smile:",
+		EmojiVersion: 0.6,
 	},
 	"laughing": {
-		Emoji:     "😆",
-		Shortcode: ":laughing:",
-		HTML:      "&#x1f606;",
-		Unicode:   "\\U0001F606",
+		Emoji:        "😆",
+		Shortcode:    ":laughing:",
+		EmojiVersion: 0.6,
 	},
 	"wink": {
-		Emoji:     "😉",
-		Shortcode: ":wink:",
-		HTML:      "&#x1f609;",
-		Unicode:   "\\U0001F609",
+		Emoji:        "😉",
+		Shortcode:    ":wink:",
+		EmojiVersion: 0.6,
 	},
 	"blush": {
-		Emoji:     "😊",
-		Shortcode: ":blush:",
-		HTML:      "&#x1f60a;",
-		Unicode:   "\\U0001F60A",
+		Emoji:        "😊",
+		Shortcode:    ":blush:",
+		EmojiVersion: 0.6,
 	},
 	"yum": {
-		Emoji:     "😋",
-		Shortcode: ":yum:",
-		HTML:      "&#x1f60b;",
-		Unicode:   "\\U0001F60B",
+		Emoji:        "😋",
+		Shortcode:    ":yum:",
+		EmojiVersion: 0.6,
 	},
 
 	// === NEUTRAL/COOL FACE EMOJIS ===
 	"sunglasses": {
-		Emoji:     "😎",
-		Shortcode: ":sunglasses:",
-		HTML:      "&#x1f60e;",
-		Unicode:   "\\U0001F60E",
+		Emoji:        "😎",
+		Shortcode:    ":sunglasses:",
+		EmojiVersion: 1.0,
 	},
 	"heart_eyes": {
-		Emoji:     "😍",
-		Shortcode: ":heart_eyes:",
-		HTML:      "&#x1f60d;",
-		Unicode:   "\\U0001F60D",
+		Emoji:        "😍",
+		Shortcode:    ":heart_eyes:",
+		EmojiVersion: 0.6,
 	},
 	"kissing_heart": {
-		Emoji:     "😘",
-		Shortcode: ":kissing_heart:",
-		HTML:      "&#x1f618;",
-		Unicode:   "\\U0001F618",
+		Emoji:        "😘",
+		Shortcode:    ":kissing_heart:",
+		EmojiVersion: 0.6,
 	},
 	"kissing": {
-		Emoji:     "😗",
-		Shortcode: ":kissing:",
-		HTML:      "&#x1f617;",
-		Unicode:   "\\U0001F617",
+		Emoji:        "😗",
+		Shortcode:    ":kissing:",
+		EmojiVersion: 1.0,
 	},
 	"kissing_smiling_eyes": {
-		Emoji:     "😙",
-		Shortcode: ":kissing_smiling_eyes:",
-		HTML:      "&#x1f619;",
-		Unicode:   "\\U0001F619",
+		Emoji:        "😙",
+		Shortcode:    ":kissing_smiling_eyes:",
+		EmojiVersion: 1.0,
 	},
 	"kissing_closed_eyes": {
-		Emoji:     "😚",
-		Shortcode: ":kissing_closed_eyes:",
-		HTML:      "&#x1f61a;",
-		Unicode:   "\\U0001F61A",
+		Emoji:        "😚",
+		Shortcode:    ":kissing_closed_eyes:",
+		EmojiVersion: 0.6,
 	},
 	"relaxed": {
-		Emoji:     "☺️",
-		Shortcode: ":relaxed:",
-		HTML:      "&#x263a;&#xfe0f;",
-		Unicode:   "\\U0000263A\\uFE0F",
+		Emoji:        "☺️",
+		Shortcode:    ":relaxed:",
+		EmojiVersion: 0.6,
 	},
 	"slight_smile": {
-		Emoji:     "🙂",
-		Shortcode: ":slight_smile:",
-		HTML:      "&#x1f642;",
-		Unicode:   "\\U0001F642",
+		Emoji:        "🙂",
+		Shortcode:    ":slight_smile:",
+		EmojiVersion: 1.0,
 	},
 	"upside_down": {
-		Emoji:     "🙃",
-		Shortcode: ":upside_down:",
-		HTML:      "&#x1f643;",
-		Unicode:   "\\U0001F643",
+		Emoji:        "🙃",
+		Shortcode:    ":upside_down:",
+		EmojiVersion: 1.0,
 	},
 
 	// === THINKING/NEUTRAL FACE EMOJIS ===
 	"thinking": {
-		Emoji:     "🤔",
-		Shortcode: ":thinking:",
-		HTML:      "&#x1f914;",
-		Unicode:   "\\U0001F914",
+		Emoji:        "🤔",
+		Shortcode:    ":thinking:",
+		EmojiVersion: 1.0,
 	},
 	"neutral_face": {
-		Emoji:     "😐",
-		Shortcode: ":neutral_face:",
-		HTML:      "&#x1f610;",
-		Unicode:   "\\U0001F610",
+		Emoji:        "😐",
+		Shortcode:    ":neutral_face:",
+		EmojiVersion: 0.7,
 	},
 	"expressionless": {
-		Emoji:     "😑",
-		Shortcode: ":expressionless:",
-		HTML:      "&#x1f611;",
-		Unicode:   "\\U0001F611",
+		Emoji:        "😑",
+		Shortcode:    ":expressionless:",
+		EmojiVersion: 1.0,
 	},
 	"no_mouth": {
-		Emoji:     "😶",
-		Shortcode: ":no_mouth:",
-		HTML:      "&#x1f636;",
-		Unicode:   "\\U0001F636",
+		Emoji:        "😶",
+		Shortcode:    ":no_mouth:",
+		EmojiVersion: 1.0,
 	},
 
 	// === SAD/CONCERNED FACE EMOJIS ===
 	"confused": {
-		Emoji:     "😕",
-		Shortcode: ":confused:",
-		HTML:      "&#x1f615;",
-		Unicode:   "\\U0001F615",
+		Emoji:        "😕",
+		Shortcode:    ":confused:",
+		EmojiVersion: 1.0,
 	},
 	"worried": {
-		Emoji:     "😟",
-		Shortcode: ":worried:",
-		HTML:      "&#x1f61f;",
-		Unicode:   "\\U0001F61F",
+		Emoji:        "😟",
+		Shortcode:    ":worried:",
+		EmojiVersion: 1.0,
 	},
 	"slightly_frowning": {
-		Emoji:     "🙁",
-		Shortcode: ":slightly_frowning:",
-		HTML:      "&#x1f641;",
-		Unicode:   "\\U0001F641",
+		Emoji:        "🙁",
+		Shortcode:    ":slightly_frowning:",
+		EmojiVersion: 1.0,
 	},
 	"frowning": {
-		Emoji:     "☹️",
-		Shortcode: ":frowning:",
-		HTML:      "&#x2639;&#xfe0f;",
-		Unicode:   "\\U00002639\\uFE0F",
+		Emoji:        "☹️",
+		Shortcode:    ":frowning:",
+		EmojiVersion: 0.7,
 	},
 	"persevere": {
-		Emoji:     "😣",
-		Shortcode: ":persevere:",
-		HTML:      "&#x1f623;",
-		Unicode:   "\\U0001F623",
+		Emoji:        "😣",
+		Shortcode:    ":persevere:",
+		EmojiVersion: 0.6,
 	},
 	"confounded": {
-		Emoji:     "😖",
-		Shortcode: ":confounded:",
-		HTML:      "&#x1f616;",
-		Unicode:   "\\U0001F616",
+		Emoji:        "😖",
+		Shortcode:    ":confounded:",
+		EmojiVersion: 0.6,
 	},
 	"tired_face": {
-		Emoji:     "😫",
-		Shortcode: ":tired_face:",
-		HTML:      "&#x1f62b;",
-		Unicode:   "\\U0001F62B",
+		Emoji:        "😫",
+		Shortcode:    ":tired_face:",
+		EmojiVersion: 0.6,
 	},
 	"weary": {
-		Emoji:     "😩",
-		Shortcode: ":weary:",
-		HTML:      "&#x1f629;",
-		Unicode:   "\\U0001F629",
+		Emoji:        "😩",
+		Shortcode:    ":weary:",
+		EmojiVersion: 0.6,
 	},
 	"cry": {
-		Emoji:     "😢",
-		Shortcode: ":cry:",
-		HTML:      "&#x1f622;",
-		Unicode:   "\\U0001F622",
+		Emoji:        "😢",
+		Shortcode:    ":cry:",
+		EmojiVersion: 0.6,
 	},
 	"sob": {
-		Emoji:     "😭",
-		Shortcode: ":sob:",
-		HTML:      "&#x1f62d;",
-		Unicode:   "\\U0001F62D",
+		Emoji:        "😭",
+		Shortcode:    ":sob:",
+		EmojiVersion: 0.6,
 	},
 
 	// === ANGRY/UPSET FACE EMOJIS ===
 	"angry": {
-		Emoji:     "😠",
-		Shortcode: ":angry:",
-		HTML:      "&#x1f620;",
-		Unicode:   "\\U0001F620",
+		Emoji:        "😠",
+		Shortcode:    ":angry:",
+		EmojiVersion: 0.6,
 	},
 	"rage": {
-		Emoji:     "😡",
-		Shortcode: ":rage:",
-		HTML:      "&#x1f621;",
-		Unicode:   "\\U0001F621",
+		Emoji:        "😡",
+		Shortcode:    ":rage:",
+		EmojiVersion: 0.6,
 	},
 	"triumph": {
-		Emoji:     "😤",
-		Shortcode: ":triumph:",
-		HTML:      "&#x1f624;",
-		Unicode:   "\\U0001F624",
+		Emoji:        "😤",
+		Shortcode:    ":triumph:",
+		EmojiVersion: 0.6,
 	},
 
 	// === SURPRISED/SHOCKED FACE EMOJIS ===
 	"open_mouth": {
-		Emoji:     "😮",
-		Shortcode: ":open_mouth:",
-		HTML:      "&#x1f62e;",
-		Unicode:   "\\U0001F62E",
+		Emoji:        "😮",
+		Shortcode:    ":open_mouth:",
+		EmojiVersion: 1.0,
 	},
 	"scream": {
-		Emoji:     "😱",
-		Shortcode: ":scream:",
-		HTML:      "&#x1f631;",
-		Unicode:   "\\U0001F631",
+		Emoji:        "😱",
+		Shortcode:    ":scream:",
+		EmojiVersion: 0.6,
 	},
 	"fearful": {
-		Emoji:     "😨",
-		Shortcode: ":fearful:",
-		HTML:      "&#x1f628;",
-		Unicode:   "\\U0001F628",
+		Emoji:        "😨",
+		Shortcode:    ":fearful:",
+		EmojiVersion: 0.6,
 	},
 	"cold_sweat": {
-		Emoji:     "😰",
-		Shortcode: ":cold_sweat:",
-		HTML:      "&#x1f630;",
-		Unicode:   "\\U0001F630",
+		Emoji:        "😰",
+		Shortcode:    ":cold_sweat:",
+		EmojiVersion: 0.6,
 	},
 	"hushed": {
-		Emoji:     "😯",
-		Shortcode: ":hushed:",
-		HTML:      "&#x1f62f;",
-		Unicode:   "\\U0001F62F",
+		Emoji:        "😯",
+		Shortcode:    ":hushed:",
+		EmojiVersion: 1.0,
 	},
 	"flushed": {
-		Emoji:     "😳",
-		Shortcode: ":flushed:",
-		HTML:      "&#x1f633;",
-		Unicode:   "\\U0001F633",
+		Emoji:        "😳",
+		Shortcode:    ":flushed:",
+		EmojiVersion: 0.6,
 	},
 
 	// === SICK/UNWELL FACE EMOJIS ===
 	"dizzy_face": {
-		Emoji:     "😵",
-		Shortcode: ":dizzy_face:",
-		HTML:      "&#x1f635;",
-		Unicode:   "\\U0001F635",
+		Emoji:        "😵",
+		Shortcode:    ":dizzy_face:",
+		EmojiVersion: 0.6,
 	},
 	"mask": {
-		Emoji:     "😷",
-		Shortcode: ":mask:",
-		HTML:      "&#x1f637;",
-		Unicode:   "\\U0001F637",
+		Emoji:        "😷",
+		Shortcode:    ":mask:",
+		EmojiVersion: 0.6,
 	},
 
 	// === ANIMALS ===
 	"dog": {
-		Emoji:     "🐶",
-		Shortcode: ":dog:",
-		HTML:      "&#x1f436;",
-		Unicode:   "\\U0001F436",
+		Emoji:        "🐶",
+		Shortcode:    ":dog:",
+		EmojiVersion: 0.6,
 	},
 	"cat": {
-		Emoji:     "🐱",
-		Shortcode: ":cat:",
-		HTML:      "&#x1f431;",
-		Unicode:   "\\U0001F431",
+		Emoji:        "🐱",
+		Shortcode:    ":cat:",
+		EmojiVersion: 0.6,
 	},
 	"mouse": {
-		Emoji:     "🐭",
-		Shortcode: ":mouse:",
-		HTML:      "&#x1f42d;",
-		Unicode:   "\\U0001F42D",
+		Emoji:        "🐭",
+		Shortcode:    ":mouse:",
+		EmojiVersion: 0.6,
 	},
 	"hamster": {
-		Emoji:     "🐹",
-		Shortcode: ":hamster:",
-		HTML:      "&#x1f439;",
-		Unicode:   "\\U0001F439",
+		Emoji:        "🐹",
+		Shortcode:    ":hamster:",
+		EmojiVersion: 0.6,
 	},
 	"rabbit": {
-		Emoji:     "🐰",
-		Shortcode: ":rabbit:",
-		HTML:      "&#x1f430;",
-		Unicode:   "\\U0001F430",
+		Emoji:        "🐰",
+		Shortcode:    ":rabbit:",
+		EmojiVersion: 0.6,
 	},
 	"bear": {
-		Emoji:     "🐻",
-		Shortcode: ":bear:",
-		HTML:      "&#x1f43b;",
-		Unicode:   "\\U0001F43B",
+		Emoji:        "🐻",
+		Shortcode:    ":bear:",
+		EmojiVersion: 0.6,
 	},
 	"panda_face": {
-		Emoji:     "🐼",
-		Shortcode: ":panda_face:",
-		HTML:      "&#x1f43c;",
-		Unicode:   "\\U0001F43C",
+		Emoji:        "🐼",
+		Shortcode:    ":panda_face:",
+		EmojiVersion: 0.6,
 	},
 	"koala": {
-		Emoji:     "🐨",
-		Shortcode: ":koala:",
-		HTML:      "&#x1f428;",
-		Unicode:   "\\U0001F428",
+		Emoji:        "🐨",
+		Shortcode:    ":koala:",
+		EmojiVersion: 0.6,
 	},
 	"tiger": {
-		Emoji:     "🐯",
-		Shortcode: ":tiger:",
-		HTML:      "&#x1f42f;",
-		Unicode:   "\\U0001F42F",
+		Emoji:        "🐯",
+		Shortcode:    ":tiger:",
+		EmojiVersion: 0.6,
 	},
 	"lion_face": {
-		Emoji:     "🦁",
-		Shortcode: ":lion_face:",
-		HTML:      "&#x1f981;",
-		Unicode:   "\\U0001F981",
+		Emoji:        "🦁",
+		Shortcode:    ":lion_face:",
+		EmojiVersion: 1.0,
 	},
 	"cow": {
-		Emoji:     "🐮",
-		Shortcode: ":cow:",
-		HTML:      "&#x1f42e;",
-		Unicode:   "\\U0001F42E",
+		Emoji:        "🐮",
+		Shortcode:    ":cow:",
+		EmojiVersion: 0.6,
 	},
 	"pig": {
-		Emoji:     "🐷",
-		Shortcode: ":pig:",
-		HTML:      "&#x1f437;",
-		Unicode:   "\\U0001F437",
+		Emoji:        "🐷",
+		Shortcode:    ":pig:",
+		EmojiVersion: 0.6,
 	},
 	"pig_nose": {
-		Emoji:     "🐽",
-		Shortcode: ":pig_nose:",
-		HTML:      "&#x1f43d;",
-		Unicode:   "\\U0001F43D",
+		Emoji:        "🐽",
+		Shortcode:    ":pig_nose:",
+		EmojiVersion: 0.6,
 	},
 	"frog": {
-		Emoji:     "🐸",
-		Shortcode: ":frog:",
-		HTML:      "&#x1f438;",
-		Unicode:   "\\U0001F438",
+		Emoji:        "🐸",
+		Shortcode:    ":frog:",
+		EmojiVersion: 0.6,
 	},
 	"octopus": {
-		Emoji:     "🐙",
-		Shortcode: ":octopus:",
-		HTML:      "&#x1f419;",
-		Unicode:   "\\U0001F419",
+		Emoji:        "🐙",
+		Shortcode:    ":octopus:",
+		EmojiVersion: 0.6,
 	},
 	"monkey_face": {
-		Emoji:     "🐵",
-		Shortcode: ":monkey_face:",
-		HTML:      "&#x1f435;",
-		Unicode:   "\\U0001F435",
+		Emoji:        "🐵",
+		Shortcode:    ":monkey_face:",
+		EmojiVersion: 0.6,
 	},
 	"see_no_evil": {
-		Emoji:     "🙈",
-		Shortcode: ":see_no_evil:",
-		HTML:      "&#x1f648;",
-		Unicode:   "\\U0001F648",
+		Emoji:        "🙈",
+		Shortcode:    ":see_no_evil:",
+		EmojiVersion: 0.6,
 	},
 	"hear_no_evil": {
-		Emoji:     "🙉",
-		Shortcode: ":hear_no_evil:",
-		HTML:      "&#x1f649;",
-		Unicode:   "\\U0001F649",
+		Emoji:        "🙉",
+		Shortcode:    ":hear_no_evil:",
+		EmojiVersion: 0.6,
 	},
 	"speak_no_evil": {
-		Emoji:     "🙊",
-		Shortcode: ":speak_no_evil:",
-		HTML:      "&#x1f64a;",
-		Unicode:   "\\U0001F64A",
+		Emoji:        "🙊",
+		Shortcode:    ":speak_no_evil:",
+		EmojiVersion: 0.6,
 	},
 
 	// === HANDS AND GESTURES ===
 	"thumbs_up": {
-		Emoji:     "👍",
-		Shortcode: ":thumbs_up:",
-		HTML:      "&#x1f44d;",
-		Unicode:   "\\U0001F44D",
+		Emoji:        "👍",
+		Shortcode:    ":thumbs_up:",
+		EmojiVersion: 0.6,
 	},
 	"thumbs_down": {
-		Emoji:     "👎",
-		Shortcode: ":thumbs_down:",
-		HTML:      "&#x1f44e;",
-		Unicode:   "\\U0001F44E",
+		Emoji:        "👎",
+		Shortcode:    ":thumbs_down:",
+		EmojiVersion: 0.6,
 	},
 	"clap": {
-		Emoji:     "👏",
-		Shortcode: ":clap:",
-		HTML:      "&#x1f44f;",
-		Unicode:   "\\U0001F44F",
+		Emoji:        "👏",
+		Shortcode:    ":clap:",
+		EmojiVersion: 0.6,
 	},
 	"raised_hands": {
-		Emoji:     "🙌",
-		Shortcode: ":raised_hands:",
-		HTML:      "&#x1f64c;",
-		Unicode:   "\\U0001F64C",
+		Emoji:        "🙌",
+		Shortcode:    ":raised_hands:",
+		EmojiVersion: 0.6,
 	},
 	"open_hands": {
-		Emoji:     "👐",
-		Shortcode: ":open_hands:",
-		HTML:      "&#x1f450;",
-		Unicode:   "\\U0001F450",
+		Emoji:        "👐",
+		Shortcode:    ":open_hands:",
+		EmojiVersion: 0.6,
 	},
 	"point_up": {
-		Emoji:     "☝️",
-		Shortcode: ":point_up:",
-		HTML:      "&#x261d;&#xfe0f;",
-		Unicode:   "\\U0000261D\\uFE0F",
+		Emoji:        "☝️",
+		Shortcode:    ":point_up:",
+		EmojiVersion: 0.6,
 	},
 	"point_down": {
-		Emoji:     "👇",
-		Shortcode: ":point_down:",
-		HTML:      "&#x1f447;",
-		Unicode:   "\\U0001F447",
+		Emoji:        "👇",
+		Shortcode:    ":point_down:",
+		EmojiVersion: 0.6,
 	},
 	"point_left": {
-		Emoji:     "👈",
-		Shortcode: ":point_left:",
-		HTML:      "&#x1f448;",
-		Unicode:   "\\U0001F448",
+		Emoji:        "👈",
+		Shortcode:    ":point_left:",
+		EmojiVersion: 0.6,
 	},
 	"point_right": {
-		Emoji:     "👉",
-		Shortcode: ":point_right:",
-		HTML:      "&#x1f449;",
-		Unicode:   "\\U0001F449",
+		Emoji:        "👉",
+		Shortcode:    ":point_right:",
+		EmojiVersion: 0.6,
 	},
 	"ok_hand": {
-		Emoji:     "👌",
-		Shortcode: ":ok_hand:",
-		HTML:      "&#x1f44c;",
-		Unicode:   "\\U0001F44C",
+		Emoji:        "👌",
+		Shortcode:    ":ok_hand:",
+		EmojiVersion: 0.6,
 	},
 	"peace": {
-		Emoji:     "✌️",
-		Shortcode: ":peace:",
-		HTML:      "&#x270c;&#xfe0f;",
-		Unicode:   "\\U0000270C\\uFE0F",
+		Emoji:        "✌️",
+		Shortcode:    ":peace:",
+		EmojiVersion: 0.6,
 	},
 	"crossed_fingers": {
-		Emoji:     "🤞",
-		Shortcode: ":crossed_fingers:",
-		HTML:      "&#x1f91e;",
-		Unicode:   "\\U0001F91E",
+		Emoji:        "🤞",
+		Shortcode:    ":crossed_fingers:",
+		EmojiVersion: 3.0,
 	},
 	"metal": {
-		Emoji:     "🤘",
-		Shortcode: ":metal:",
-		HTML:      "&#x1f918;",
-		Unicode:   "\\U0001F918",
+		Emoji:        "🤘",
+		Shortcode:    ":metal:",
+		EmojiVersion: 1.0,
 	},
 	"call_me": {
-		Emoji:     "🤙",
-		Shortcode: ":call_me:",
-		HTML:      "&#x1f919;",
-		Unicode:   "\\U0001F919",
+		Emoji:        "🤙",
+		Shortcode:    ":call_me:",
+		EmojiVersion: 3.0,
 	},
 	"fist": {
-		Emoji:     "✊",
-		Shortcode: ":fist:",
-		HTML:      "&#x270a;",
-		Unicode:   "\\U0000270A",
+		Emoji:        "✊",
+		Shortcode:    ":fist:",
+		EmojiVersion: 0.6,
 	},
 	"punch": {
-		Emoji:     "👊",
-		Shortcode: ":punch:",
-		HTML:      "&#x1f44a;",
-		Unicode:   "\\U0001F44A",
+		Emoji:        "👊",
+		Shortcode:    ":punch:",
+		EmojiVersion: 0.6,
 	},
 	"left_fist": {
-		Emoji:     "🤛",
-		Shortcode: ":left_fist:",
-		HTML:      "&#x1f91b;",
-		Unicode:   "\\U0001F91B",
+		Emoji:        "🤛",
+		Shortcode:    ":left_fist:",
+		EmojiVersion: 3.0,
 	},
 	"right_fist": {
-		Emoji:     "🤜",
-		Shortcode: ":right_fist:",
-		HTML:      "&#x1f91c;",
-		Unicode:   "\\U0001F91C",
+		Emoji:        "🤜",
+		Shortcode:    ":right_fist:",
+		EmojiVersion: 3.0,
 	},
 	"wave": {
-		Emoji:     "👋",
-		Shortcode: ":wave:",
-		HTML:      "&#x1f44b;",
-		Unicode:   "\\U0001F44B",
+		Emoji:        "👋",
+		Shortcode:    ":wave:",
+		EmojiVersion: 0.6,
 	},
 	"pray": {
-		Emoji:     "🙏",
-		Shortcode: ":pray:",
-		HTML:      "&#x1f64f;",
-		Unicode:   "\\U0001F64F",
+		Emoji:        "🙏",
+		Shortcode:    ":pray:",
+		EmojiVersion: 0.6,
 	},
 	"raised_hand": {
-		Emoji:     "✋",
-		Shortcode: ":raised_hand:",
-		HTML:      "&#x270b;",
-		Unicode:   "\\U0000270B",
+		Emoji:        "✋",
+		Shortcode:    ":raised_hand:",
+		EmojiVersion: 0.6,
 	},
 	"hand_splayed": {
-		Emoji:     "🖐️",
-		Shortcode: ":hand_splayed:",
-		HTML:      "&#x1f590;&#xfe0f;",
-		Unicode:   "\\U0001F590\\uFE0F",
+		Emoji:        "🖐️",
+		Shortcode:    ":hand_splayed:",
+		EmojiVersion: 0.7,
 	},
 	"vulcan": {
-		Emoji:     "🖖",
-		Shortcode: ":vulcan:",
-		HTML:      "&#x1f596;",
-		Unicode:   "\\U0001F596",
+		Emoji:        "🖖",
+		Shortcode:    ":vulcan:",
+		EmojiVersion: 1.0,
 	},
 	"love_you_gesture": {
-		Emoji:     "🤟",
-		Shortcode: ":love_you_gesture:",
-		HTML:      "&#x1f91f;",
-		Unicode:   "\\U0001F91F",
+		Emoji:        "🤟",
+		Shortcode:    ":love_you_gesture:",
+		EmojiVersion: 5.0,
 	},
 	"pinching_hand": {
-		Emoji:     "🤏",
-		Shortcode: ":pinching_hand:",
-		HTML:      "&#x1f90f;",
-		Unicode:   "\\U0001F90F",
+		Emoji:        "🤏",
+		Shortcode:    ":pinching_hand:",
+		EmojiVersion: 12.0,
 	},
 	"pinched_fingers": {
-		Emoji:     "🤌",
-		Shortcode: ":pinched_fingers:",
-		HTML:      "&#x1f90c;",
-		Unicode:   "\\U0001F90C",
+		Emoji:        "🤌",
+		Shortcode:    ":pinched_fingers:",
+		EmojiVersion: 13.0,
 	},
 
 	// === PEOPLE ===
 	"woman_teacher": {
-		Emoji:     "👩🏽‍🏫",
-		Shortcode: ":woman_teacher:",
-		HTML:      "&#x1f469;&#x1f3fd;&#x200d;&#x1f3eb;",
-		Unicode:   "\\U0001F469\\U0001F3FD\\u200D\\U0001F3EB",
+		Emoji:        "👩🏽‍🏫",
+		Shortcode:    ":woman_teacher:",
+		EmojiVersion: 4.0,
+	},
+	"woman_teacher_default_skin_tone": {
+		Emoji:        "👩\u200D🏫",
+		Shortcode:    ":woman_teacher_default_skin_tone:",
+		EmojiVersion: 4.0,
+	},
+	"man_teacher": {
+		Emoji:        "👨\u200D🏫",
+		Shortcode:    ":man_teacher:",
+		EmojiVersion: 4.0,
+	},
+	"man_teacher_medium_skin_tone": {
+		Emoji:        "👨🏽\u200D🏫",
+		Shortcode:    ":man_teacher_medium_skin_tone:",
+		EmojiVersion: 4.0,
+	},
+	"teacher": {
+		Emoji:        "🧑\u200D🏫",
+		Shortcode:    ":teacher:",
+		EmojiVersion: 12.1,
+	},
+	"teacher_medium_skin_tone": {
+		Emoji:        "🧑🏽\u200D🏫",
+		Shortcode:    ":teacher_medium_skin_tone:",
+		EmojiVersion: 12.1,
+	},
+	"person": {
+		Emoji:        "🧑",
+		Shortcode:    ":person:",
+		EmojiVersion: 5.0,
+	},
+	"man": {
+		Emoji:        "👨",
+		Shortcode:    ":man:",
+		EmojiVersion: 0.6,
+	},
+	"woman": {
+		Emoji:        "👩",
+		Shortcode:    ":woman:",
+		EmojiVersion: 0.6,
+	},
+	"person_red_hair": {
+		Emoji:        "🧑\u200D🦰",
+		Shortcode:    ":person_red_hair:",
+		EmojiVersion: 12.1,
+	},
+	"person_curly_hair": {
+		Emoji:        "🧑\u200D🦱",
+		Shortcode:    ":person_curly_hair:",
+		EmojiVersion: 12.1,
+	},
+	"person_white_hair": {
+		Emoji:        "🧑\u200D🦳",
+		Shortcode:    ":person_white_hair:",
+		EmojiVersion: 12.1,
+	},
+	"person_bald": {
+		Emoji:        "🧑\u200D🦲",
+		Shortcode:    ":person_bald:",
+		EmojiVersion: 12.1,
+	},
+	"man_red_hair": {
+		Emoji:        "👨\u200D🦰",
+		Shortcode:    ":man_red_hair:",
+		EmojiVersion: 11.0,
+	},
+	"man_curly_hair": {
+		Emoji:        "👨\u200D🦱",
+		Shortcode:    ":man_curly_hair:",
+		EmojiVersion: 11.0,
+	},
+	"man_white_hair": {
+		Emoji:        "👨\u200D🦳",
+		Shortcode:    ":man_white_hair:",
+		EmojiVersion: 11.0,
+	},
+	"man_bald": {
+		Emoji:        "👨\u200D🦲",
+		Shortcode:    ":man_bald:",
+		EmojiVersion: 11.0,
+	},
+	"woman_red_hair": {
+		Emoji:        "👩\u200D🦰",
+		Shortcode:    ":woman_red_hair:",
+		EmojiVersion: 11.0,
+	},
+	"woman_curly_hair": {
+		Emoji:        "👩\u200D🦱",
+		Shortcode:    ":woman_curly_hair:",
+		EmojiVersion: 11.0,
+	},
+	"woman_white_hair": {
+		Emoji:        "👩\u200D🦳",
+		Shortcode:    ":woman_white_hair:",
+		EmojiVersion: 11.0,
+	},
+	"woman_bald": {
+		Emoji:        "👩\u200D🦲",
+		Shortcode:    ":woman_bald:",
+		EmojiVersion: 11.0,
+	},
+	"firefighter": {
+		Emoji:        "🧑\u200D🚒",
+		Shortcode:    ":firefighter:",
+		EmojiVersion: 12.1,
+	},
+	"man_firefighter": {
+		Emoji:        "👨\u200D🚒",
+		Shortcode:    ":man_firefighter:",
+		EmojiVersion: 4.0,
+	},
+	"woman_firefighter": {
+		Emoji:        "👩\u200D🚒",
+		Shortcode:    ":woman_firefighter:",
+		EmojiVersion: 4.0,
+	},
+	"health_worker": {
+		Emoji:        "🧑\u200D⚕\uFE0F",
+		Shortcode:    ":health_worker:",
+		EmojiVersion: 12.1,
+	},
+	"man_health_worker": {
+		Emoji:        "👨\u200D⚕\uFE0F",
+		Shortcode:    ":man_health_worker:",
+		EmojiVersion: 4.0,
+	},
+	"woman_health_worker": {
+		Emoji:        "👩\u200D⚕\uFE0F",
+		Shortcode:    ":woman_health_worker:",
+		EmojiVersion: 4.0,
+	},
+	"cook": {
+		Emoji:        "🧑\u200D🍳",
+		Shortcode:    ":cook:",
+		EmojiVersion: 12.1,
+	},
+	"man_cook": {
+		Emoji:        "👨\u200D🍳",
+		Shortcode:    ":man_cook:",
+		EmojiVersion: 4.0,
+	},
+	"woman_cook": {
+		Emoji:        "👩\u200D🍳",
+		Shortcode:    ":woman_cook:",
+		EmojiVersion: 4.0,
+	},
+	"technologist": {
+		Emoji:        "🧑\u200D💻",
+		Shortcode:    ":technologist:",
+		EmojiVersion: 12.1,
+	},
+	"man_technologist": {
+		Emoji:        "👨\u200D💻",
+		Shortcode:    ":man_technologist:",
+		EmojiVersion: 4.0,
+	},
+	"woman_technologist": {
+		Emoji:        "👩\u200D💻",
+		Shortcode:    ":woman_technologist:",
+		EmojiVersion: 4.0,
+	},
+	"astronaut": {
+		Emoji:        "🧑\u200D🚀",
+		Shortcode:    ":astronaut:",
+		EmojiVersion: 12.1,
+	},
+	"man_astronaut": {
+		Emoji:        "👨\u200D🚀",
+		Shortcode:    ":man_astronaut:",
+		EmojiVersion: 4.0,
+	},
+	"woman_astronaut": {
+		Emoji:        "👩\u200D🚀",
+		Shortcode:    ":woman_astronaut:",
+		EmojiVersion: 4.0,
+	},
+	"person_shrugging": {
+		Emoji:        "🤷",
+		Shortcode:    ":person_shrugging:",
+		EmojiVersion: 3.0,
+	},
+	"man_shrugging": {
+		Emoji:        "🤷\u200D♂\uFE0F",
+		Shortcode:    ":man_shrugging:",
+		EmojiVersion: 4.0,
+	},
+	"woman_shrugging": {
+		Emoji:        "🤷\u200D♀\uFE0F",
+		Shortcode:    ":woman_shrugging:",
+		EmojiVersion: 4.0,
+	},
+	"person_facepalming": {
+		Emoji:        "🤦",
+		Shortcode:    ":person_facepalming:",
+		EmojiVersion: 3.0,
+	},
+	"man_facepalming": {
+		Emoji:        "🤦\u200D♂\uFE0F",
+		Shortcode:    ":man_facepalming:",
+		EmojiVersion: 4.0,
+	},
+	"woman_facepalming": {
+		Emoji:        "🤦\u200D♀\uFE0F",
+		Shortcode:    ":woman_facepalming:",
+		EmojiVersion: 4.0,
 	},
 
 	// === PEOPLE ===
 	"eyes": {
-		Emoji:     "👀",
-		Shortcode: ":eyes:",
-		HTML:      "&#x1f440;",
-		Unicode:   "\\U0001F440",
+		Emoji:        "👀",
+		Shortcode:    ":eyes:",
+		EmojiVersion: 0.6,
 	},
 
 	// === HEARTS ===
 	"heart": {
-		Emoji:     "❤️",
-		Shortcode: ":heart:",
-		HTML:      "&#x2764;&#xfe0f;",
-		Unicode:   "\\U00002764\\uFE0F",
+		Emoji:        "❤️",
+		Shortcode:    ":heart:",
+		EmojiVersion: 0.6,
 	},
 	"yellow_heart": {
-		Emoji:     "💛",
-		Shortcode: ":yellow_heart:",
-		HTML:      "&#x1f49b;",
-		Unicode:   "\\U0001F49B",
+		Emoji:        "💛",
+		Shortcode:    ":yellow_heart:",
+		EmojiVersion: 0.6,
 	},
 	"green_heart": {
-		Emoji:     "💚",
-		Shortcode: ":green_heart:",
-		HTML:      "&#x1f49a;",
-		Unicode:   "\\U0001F49A",
+		Emoji:        "💚",
+		Shortcode:    ":green_heart:",
+		EmojiVersion: 0.6,
 	},
 	"blue_heart": {
-		Emoji:     "💙",
-		Shortcode: ":blue_heart:",
-		HTML:      "&#x1f499;",
-		Unicode:   "\\U0001F499",
+		Emoji:        "💙",
+		Shortcode:    ":blue_heart:",
+		EmojiVersion: 0.6,
 	},
 	"purple_heart": {
-		Emoji:     "💜",
-		Shortcode: ":purple_heart:",
-		HTML:      "&#x1f49c;",
-		Unicode:   "\\U0001F49C",
+		Emoji:        "💜",
+		Shortcode:    ":purple_heart:",
+		EmojiVersion: 0.6,
 	},
 	"black_heart": {
-		Emoji:     "🖤",
-		Shortcode: ":black_heart:",
-		HTML:      "&#x1f5a4;",
-		Unicode:   "\\U0001F5A4",
+		Emoji:        "🖤",
+		Shortcode:    ":black_heart:",
+		EmojiVersion: 3.0,
 	},
 	"broken_heart": {
-		Emoji:     "💔",
-		Shortcode: ":broken_heart:",
-		HTML:      "&#x1f494;",
-		Unicode:   "\\U0001F494",
+		Emoji:        "💔",
+		Shortcode:    ":broken_heart:",
+		EmojiVersion: 0.6,
 	},
 	"two_hearts": {
-		Emoji:     "💕",
-		Shortcode: ":two_hearts:",
-		HTML:      "&#x1f495;",
-		Unicode:   "\\U0001F495",
+		Emoji:        "💕",
+		Shortcode:    ":two_hearts:",
+		EmojiVersion: 0.6,
 	},
 	"sparkling_heart": {
-		Emoji:     "💖",
-		Shortcode: ":sparkling_heart:",
-		HTML:      "&#x1f496;",
-		Unicode:   "\\U0001F496",
+		Emoji:        "💖",
+		Shortcode:    ":sparkling_heart:",
+		EmojiVersion: 0.6,
 	},
 	"heartpulse": {
-		Emoji:     "💗",
-		Shortcode: ":heartpulse:",
-		HTML:      "&#x1f497;",
-		Unicode:   "\\U0001F497",
+		Emoji:        "💗",
+		Shortcode:    ":heartpulse:",
+		EmojiVersion: 0.6,
 	},
 	"cupid": {
-		Emoji:     "💘",
-		Shortcode: ":cupid:",
-		HTML:      "&#x1f498;",
-		Unicode:   "\\U0001F498",
+		Emoji:        "💘",
+		Shortcode:    ":cupid:",
+		EmojiVersion: 0.6,
 	},
 
 	// === SYMBOLS ===
 	"star": {
-		Emoji:     "⭐",
-		Shortcode: ":star:",
-		HTML:      "&#x2b50;",
-		Unicode:   "\\U00002B50",
+		Emoji:        "⭐",
+		Shortcode:    ":star:",
+		EmojiVersion: 0.6,
 	},
 	"star2": {
-		Emoji:     "🌟",
-		Shortcode: ":star2:",
-		HTML:      "&#x1f31f;",
-		Unicode:   "\\U0001F31F",
+		Emoji:        "🌟",
+		Shortcode:    ":star2:",
+		EmojiVersion: 0.6,
 	},
 	"fire": {
-		Emoji:     "🔥",
-		Shortcode: ":fire:",
-		HTML:      "&#x1f525;",
-		Unicode:   "\\U0001F525",
+		Emoji:        "🔥",
+		Shortcode:    ":fire:",
+		EmojiVersion: 0.6,
 	},
 	"boom": {
-		Emoji:     "💥",
-		Shortcode: ":boom:",
-		HTML:      "&#x1f4a5;",
-		Unicode:   "\\U0001F4A5",
+		Emoji:        "💥",
+		Shortcode:    ":boom:",
+		EmojiVersion: 0.6,
 	},
 	"sparkles": {
-		Emoji:     "✨",
-		Shortcode: ":sparkles:",
-		HTML:      "&#x2728;",
-		Unicode:   "\\U00002728",
+		Emoji:        "✨",
+		Shortcode:    ":sparkles:",
+		EmojiVersion: 0.6,
 	},
 	"zap": {
-		Emoji:     "⚡",
-		Shortcode: ":zap:",
-		HTML:      "&#x26a1;",
-		Unicode:   "\\U000026A1",
+		Emoji:        "⚡",
+		Shortcode:    ":zap:",
+		EmojiVersion: 0.6,
 	},
 	"gem": {
-		Emoji:     "💎",
-		Shortcode: ":gem:",
-		HTML:      "&#x1f48e;",
-		Unicode:   "\\U0001F48E",
+		Emoji:        "💎",
+		Shortcode:    ":gem:",
+		EmojiVersion: 0.6,
 	},
 	"bomb": {
-		Emoji:     "💣",
-		Shortcode: ":bomb:",
-		HTML:      "&#x1f4a3;",
-		Unicode:   "\\U0001F4A3",
+		Emoji:        "💣",
+		Shortcode:    ":bomb:",
+		EmojiVersion: 0.6,
 	},
 
 	// === NATURE ===
 	"sunflower": {
-		Emoji:     "🌻",
-		Shortcode: ":sunflower:",
-		HTML:      "&#x1f33b;",
-		Unicode:   "\\U0001F33B",
+		Emoji:        "🌻",
+		Shortcode:    ":sunflower:",
+		EmojiVersion: 0.6,
 	},
 	"rose": {
-		Emoji:     "🌹",
-		Shortcode: ":rose:",
-		HTML:      "&#x1f339;",
-		Unicode:   "\\U0001F339",
+		Emoji:        "🌹",
+		Shortcode:    ":rose:",
+		EmojiVersion: 0.6,
 	},
 	"tulip": {
-		Emoji:     "🌷",
-		Shortcode: ":tulip:",
-		HTML:      "&#x1f337;",
-		Unicode:   "\\U0001F337",
+		Emoji:        "🌷",
+		Shortcode:    ":tulip:",
+		EmojiVersion: 0.6,
 	},
 	"cherry_blossom": {
-		Emoji:     "🌸",
-		Shortcode: ":cherry_blossom:",
-		HTML:      "&#x1f338;",
-		Unicode:   "\\U0001F338",
+		Emoji:        "🌸",
+		Shortcode:    ":cherry_blossom:",
+		EmojiVersion: 0.6,
 	},
 	"blossom": {
-		Emoji:     "🌼",
-		Shortcode: ":blossom:",
-		HTML:      "&#x1f33c;",
-		Unicode:   "\\U0001F33C",
+		Emoji:        "🌼",
+		Shortcode:    ":blossom:",
+		EmojiVersion: 0.6,
 	},
 	"hibiscus": {
-		Emoji:     "🌺",
-		Shortcode: ":hibiscus:",
-		HTML:      "&#x1f33a;",
-		Unicode:   "\\U0001F33A",
+		Emoji:        "🌺",
+		Shortcode:    ":hibiscus:",
+		EmojiVersion: 0.6,
 	},
 	"sun": {
-		Emoji:     "☀️",
-		Shortcode: ":sun:",
-		HTML:      "&#x2600;&#xfe0f;",
-		Unicode:   "\\U00002600\\uFE0F",
+		Emoji:        "☀️",
+		Shortcode:    ":sun:",
+		EmojiVersion: 0.6,
 	},
 	"sun_with_face": {
-		Emoji:     "🌞",
-		Shortcode: ":sun_with_face:",
-		HTML:      "&#x1f31e;",
-		Unicode:   "\\U0001F31E",
+		Emoji:        "🌞",
+		Shortcode:    ":sun_with_face:",
+		EmojiVersion: 1.0,
 	},
 	"sunrise": {
-		Emoji:     "🌅",
-		Shortcode: ":sunrise:",
-		HTML:      "&#x1f305;",
-		Unicode:   "\\U0001F305",
+		Emoji:        "🌅",
+		Shortcode:    ":sunrise:",
+		EmojiVersion: 0.6,
 	},
 	"sunrise_over_mountains": {
-		Emoji:     "🌄",
-		Shortcode: ":sunrise_over_mountains:",
-		HTML:      "&#x1f304;",
-		Unicode:   "\\U0001F304",
+		Emoji:        "🌄",
+		Shortcode:    ":sunrise_over_mountains:",
+		EmojiVersion: 0.6,
 	},
 	"moon": {
-		Emoji:     "🌙",
-		Shortcode: ":moon:",
-		HTML:      "&#x1f319;",
-		Unicode:   "\\U0001F319",
+		Emoji:        "🌙",
+		Shortcode:    ":moon:",
+		EmojiVersion: 0.6,
 	},
 	"full_moon": {
-		Emoji:     "🌕",
-		Shortcode: ":full_moon:",
-		HTML:      "&#x1f315;",
-		Unicode:   "\\U0001F315",
+		Emoji:        "🌕",
+		Shortcode:    ":full_moon:",
+		EmojiVersion: 0.6,
 	},
 	"new_moon": {
-		Emoji:     "🌑",
-		Shortcode: ":new_moon:",
-		HTML:      "&#x1f311;",
-		Unicode:   "\\U0001F311",
+		Emoji:        "🌑",
+		Shortcode:    ":new_moon:",
+		EmojiVersion: 0.6,
 	},
 	"partly_sunny": {
-		Emoji:     "⛅",
-		Shortcode: ":partly_sunny:",
-		HTML:      "&#x26c5;",
-		Unicode:   "\\U000026C5",
+		Emoji:        "⛅",
+		Shortcode:    ":partly_sunny:",
+		EmojiVersion: 0.6,
 	},
 	"cloud": {
-		Emoji:     "☁️",
-		Shortcode: ":cloud:",
-		HTML:      "&#x2601;&#xfe0f;",
-		Unicode:   "\\U00002601\\uFE0F",
+		Emoji:        "☁️",
+		Shortcode:    ":cloud:",
+		EmojiVersion: 0.6,
 	},
 	"rain_cloud": {
-		Emoji:     "🌧️",
-		Shortcode: ":rain_cloud:",
-		HTML:      "&#x1f327;&#xfe0f;",
-		Unicode:   "\\U0001F327\\uFE0F",
+		Emoji:        "🌧️",
+		Shortcode:    ":rain_cloud:",
+		EmojiVersion: 0.7,
 	},
 	"snowman": {
-		Emoji:     "⛄",
-		Shortcode: ":snowman:",
-		HTML:      "&#x26c4;",
-		Unicode:   "\\U000026C4",
+		Emoji:        "⛄",
+		Shortcode:    ":snowman:",
+		EmojiVersion: 0.6,
 	},
 	"snowflake": {
-		Emoji:     "❄️",
-		Shortcode: ":snowflake:",
-		HTML:      "&#x2744;&#xfe0f;",
-		Unicode:   "\\U00002744\\uFE0F",
+		Emoji:        "❄️",
+		Shortcode:    ":snowflake:",
+		EmojiVersion: 0.6,
 	},
 	"rainbow": {
-		Emoji:     "🌈",
-		Shortcode: ":rainbow:",
-		HTML:      "&#x1f308;",
-		Unicode:   "\\U0001F308",
+		Emoji:        "🌈",
+		Shortcode:    ":rainbow:",
+		EmojiVersion: 0.6,
 	},
 
 	// === EARTH & GEOGRAPHY ===
 	"earth_africa": {
-		Emoji:     "🌍",
-		Shortcode: ":earth_africa:",
-		HTML:      "&#x1f30d;",
-		Unicode:   "\\U0001F30D",
+		Emoji:        "🌍",
+		Shortcode:    ":earth_africa:",
+		EmojiVersion: 0.7,
 	},
 	"earth_americas": {
-		Emoji:     "🌎",
-		Shortcode: ":earth_americas:",
-		HTML:      "&#x1f30e;",
-		Unicode:   "\\U0001F30E",
+		Emoji:        "🌎",
+		Shortcode:    ":earth_americas:",
+		EmojiVersion: 0.7,
 	},
 	"earth_asia": {
-		Emoji:     "🌏",
-		Shortcode: ":earth_asia:",
-		HTML:      "&#x1f30f;",
-		Unicode:   "\\U0001F30F",
+		Emoji:        "🌏",
+		Shortcode:    ":earth_asia:",
+		EmojiVersion: 0.6,
 	},
 	"desert_island": {
-		Emoji:     "🏝️",
-		Shortcode: ":desert_island:",
-		HTML:      "&#x1f3dd;&#xfe0f;",
-		Unicode:   "\\U0001F3DD\\uFE0F",
+		Emoji:        "🏝️",
+		Shortcode:    ":desert_island:",
+		EmojiVersion: 0.7,
 	},
 	"classical_building": {
-		Emoji:     "🏛️",
-		Shortcode: ":classical_building:",
-		HTML:      "&#x1f3db;&#xfe0f;",
-		Unicode:   "\\U0001F3DB\\uFE0F",
+		Emoji:        "🏛️",
+		Shortcode:    ":classical_building:",
+		EmojiVersion: 0.7,
 	},
 
 	// === WATER & WAVES ===
 	"ocean": {
-		Emoji:     "🌊",
-		Shortcode: ":ocean:",
-		HTML:      "&#x1f30a;",
-		Unicode:   "\\U0001F30A",
+		Emoji:        "🌊",
+		Shortcode:    ":ocean:",
+		EmojiVersion: 0.6,
 	},
 	"droplet": {
-		Emoji:     "💧",
-		Shortcode: ":droplet:",
-		HTML:      "&#x1f4a7;",
-		Unicode:   "\\U0001F4A7",
+		Emoji:        "💧",
+		Shortcode:    ":droplet:",
+		EmojiVersion: 0.6,
 	},
 	"sweat_drops": {
-		Emoji:     "💦",
-		Shortcode: ":sweat_drops:",
-		HTML:      "&#x1f4a6;",
-		Unicode:   "\\U0001F4A6",
+		Emoji:        "💦",
+		Shortcode:    ":sweat_drops:",
+		EmojiVersion: 0.6,
 	},
 
 	// === PLANTS & TREES ===
 	"seedling": {
-		Emoji:     "🌱",
-		Shortcode: ":seedling:",
-		HTML:      "&#x1f331;",
-		Unicode:   "\\U0001F331",
+		Emoji:        "🌱",
+		Shortcode:    ":seedling:",
+		EmojiVersion: 0.6,
 	},
 	"herb": {
-		Emoji:     "🌿",
-		Shortcode: ":herb:",
-		HTML:      "&#x1f33f;",
-		Unicode:   "\\U0001F33F",
+		Emoji:        "🌿",
+		Shortcode:    ":herb:",
+		EmojiVersion: 0.6,
 	},
 	"four_leaf_clover": {
-		Emoji:     "🍀",
-		Shortcode: ":four_leaf_clover:",
-		HTML:      "&#x1f340;",
-		Unicode:   "\\U0001F340",
+		Emoji:        "🍀",
+		Shortcode:    ":four_leaf_clover:",
+		EmojiVersion: 0.6,
 	},
 	"leaves": {
-		Emoji:     "🍃",
-		Shortcode: ":leaves:",
-		HTML:      "&#x1f343;",
-		Unicode:   "\\U0001F343",
+		Emoji:        "🍃",
+		Shortcode:    ":leaves:",
+		EmojiVersion: 0.6,
 	},
 	"evergreen_tree": {
-		Emoji:     "🌲",
-		Shortcode: ":evergreen_tree:",
-		HTML:      "&#x1f332;",
-		Unicode:   "\\U0001F332",
+		Emoji:        "🌲",
+		Shortcode:    ":evergreen_tree:",
+		EmojiVersion: 1.0,
 	},
 	"deciduous_tree": {
-		Emoji:     "🌳",
-		Shortcode: ":deciduous_tree:",
-		HTML:      "&#x1f333;",
-		Unicode:   "\\U0001F333",
+		Emoji:        "🌳",
+		Shortcode:    ":deciduous_tree:",
+		EmojiVersion: 1.0,
 	},
 	"palm_tree": {
-		Emoji:     "🌴",
-		Shortcode: ":palm_tree:",
-		HTML:      "&#x1f334;",
-		Unicode:   "\\U0001F334",
+		Emoji:        "🌴",
+		Shortcode:    ":palm_tree:",
+		EmojiVersion: 0.6,
 	},
 	"cactus": {
-		Emoji:     "🌵",
-		Shortcode: ":cactus:",
-		HTML:      "&#x1f335;",
-		Unicode:   "\\U0001F335",
+		Emoji:        "🌵",
+		Shortcode:    ":cactus:",
+		EmojiVersion: 0.6,
 	},
 
 	// === FOOD ===
 	"apple": {
-		Emoji:     "🍎",
-		Shortcode: ":apple:",
-		HTML:      "&#x1f34e;",
-		Unicode:   "\\U0001F34E",
+		Emoji:        "🍎",
+		Shortcode:    ":apple:",
+		EmojiVersion: 0.6,
 	},
 	"banana": {
-		Emoji:     "🍌",
-		Shortcode: ":banana:",
-		HTML:      "&#x1f34c;",
-		Unicode:   "\\U0001F34C",
+		Emoji:        "🍌",
+		Shortcode:    ":banana:",
+		EmojiVersion: 0.6,
 	},
 	"grapes": {
-		Emoji:     "🍇",
-		Shortcode: ":grapes:",
-		HTML:      "&#x1f347;",
-		Unicode:   "\\U0001F347",
+		Emoji:        "🍇",
+		Shortcode:    ":grapes:",
+		EmojiVersion: 0.6,
 	},
 	"strawberry": {
-		Emoji:     "🍓",
-		Shortcode: ":strawberry:",
-		HTML:      "&#x1f353;",
-		Unicode:   "\\U0001F353",
+		Emoji:        "🍓",
+		Shortcode:    ":strawberry:",
+		EmojiVersion: 0.6,
 	},
 	"watermelon": {
-		Emoji:     "🍉",
-		Shortcode: ":watermelon:",
-		HTML:      "&#x1f349;",
-		Unicode:   "\\U0001F349",
+		Emoji:        "🍉",
+		Shortcode:    ":watermelon:",
+		EmojiVersion: 0.6,
 	},
 	"orange": {
-		Emoji:     "🍊",
-		Shortcode: ":orange:",
-		HTML:      "&#x1f34a;",
-		Unicode:   "\\U0001F34A",
+		Emoji:        "🍊",
+		Shortcode:    ":orange:",
+		EmojiVersion: 0.6,
 	},
 	"lemon": {
-		Emoji:     "🍋",
-		Shortcode: ":lemon:",
-		HTML:      "&#x1f34b;",
-		Unicode:   "\\U0001F34B",
+		Emoji:        "🍋",
+		Shortcode:    ":lemon:",
+		EmojiVersion: 1.0,
 	},
 	"peach": {
-		Emoji:     "🍑",
-		Shortcode: ":peach:",
-		HTML:      "&#x1f351;",
-		Unicode:   "\\U0001F351",
+		Emoji:        "🍑",
+		Shortcode:    ":peach:",
+		EmojiVersion: 0.6,
 	},
 	"cherries": {
-		Emoji:     "🍒",
-		Shortcode: ":cherries:",
-		HTML:      "&#x1f352;",
-		Unicode:   "\\U0001F352",
+		Emoji:        "🍒",
+		Shortcode:    ":cherries:",
+		EmojiVersion: 0.6,
 	},
 	"pineapple": {
-		Emoji:     "🍍",
-		Shortcode: ":pineapple:",
-		HTML:      "&#x1f34d;",
-		Unicode:   "\\U0001F34D",
+		Emoji:        "🍍",
+		Shortcode:    ":pineapple:",
+		EmojiVersion: 0.6,
 	},
 	"pizza": {
-		Emoji:     "🍕",
-		Shortcode: ":pizza:",
-		HTML:      "&#x1f355;",
-		Unicode:   "\\U0001F355",
+		Emoji:        "🍕",
+		Shortcode:    ":pizza:",
+		EmojiVersion: 0.6,
 	},
 	"hamburger": {
-		Emoji:     "🍔",
-		Shortcode: ":hamburger:",
-		HTML:      "&#x1f354;",
-		Unicode:   "\\U0001F354",
+		Emoji:        "🍔",
+		Shortcode:    ":hamburger:",
+		EmojiVersion: 0.6,
 	},
 	"hotdog": {
-		Emoji:     "🌭",
-		Shortcode: ":hotdog:",
-		HTML:      "&#x1f32d;",
-		Unicode:   "\\U0001F32D",
+		Emoji:        "🌭",
+		Shortcode:    ":hotdog:",
+		EmojiVersion: 1.0,
 	},
 	"taco": {
-		Emoji:     "🌮",
-		Shortcode: ":taco:",
-		HTML:      "&#x1f32e;",
-		Unicode:   "\\U0001F32E",
+		Emoji:        "🌮",
+		Shortcode:    ":taco:",
+		EmojiVersion: 1.0,
 	},
 	"burrito": {
-		Emoji:     "🌯",
-		Shortcode: ":burrito:",
-		HTML:      "&#x1f32f;",
-		Unicode:   "\\U0001F32F",
+		Emoji:        "🌯",
+		Shortcode:    ":burrito:",
+		EmojiVersion: 1.0,
 	},
 
 	// === DRINKS ===
 	"coffee": {
-		Emoji:     "☕",
-		Shortcode: ":coffee:",
-		HTML:      "&#x2615;",
-		Unicode:   "\\U00002615",
+		Emoji:        "☕",
+		Shortcode:    ":coffee:",
+		EmojiVersion: 0.6,
 	},
 	"tea": {
-		Emoji:     "🍵",
-		Shortcode: ":tea:",
-		HTML:      "&#x1f375;",
-		Unicode:   "\\U0001F375",
+		Emoji:        "🍵",
+		Shortcode:    ":tea:",
+		EmojiVersion: 0.6,
 	},
 	"beer": {
-		Emoji:     "🍺",
-		Shortcode: ":beer:",
-		HTML:      "&#x1f37a;",
-		Unicode:   "\\U0001F37A",
+		Emoji:        "🍺",
+		Shortcode:    ":beer:",
+		EmojiVersion: 0.6,
 	},
 	"beers": {
-		Emoji:     "🍻",
-		Shortcode: ":beers:",
-		HTML:      "&#x1f37b;",
-		Unicode:   "\\U0001F37B",
+		Emoji:        "🍻",
+		Shortcode:    ":beers:",
+		EmojiVersion: 0.6,
 	},
 	"wine_glass": {
-		Emoji:     "🍷",
-		Shortcode: ":wine_glass:",
-		HTML:      "&#x1f377;",
-		Unicode:   "\\U0001F377",
+		Emoji:        "🍷",
+		Shortcode:    ":wine_glass:",
+		EmojiVersion: 0.6,
 	},
 	"cocktail": {
-		Emoji:     "🍸",
-		Shortcode: ":cocktail:",
-		HTML:      "&#x1f378;",
-		Unicode:   "\\U0001F378",
+		Emoji:        "🍸",
+		Shortcode:    ":cocktail:",
+		EmojiVersion: 0.6,
 	},
 
 	// === SPORTS & ACTIVITIES ===
 	"soccer": {
-		Emoji:     "⚽",
-		Shortcode: ":soccer:",
-		HTML:      "&#x26bd;",
-		Unicode:   "\\U000026BD",
+		Emoji:        "⚽",
+		Shortcode:    ":soccer:",
+		EmojiVersion: 0.6,
 	},
 	"basketball": {
-		Emoji:     "🏀",
-		Shortcode: ":basketball:",
-		HTML:      "&#x1f3c0;",
-		Unicode:   "\\U0001F3C0",
+		Emoji:        "🏀",
+		Shortcode:    ":basketball:",
+		EmojiVersion: 0.6,
 	},
 	"football": {
-		Emoji:     "🏈",
-		Shortcode: ":football:",
-		HTML:      "&#x1f3c8;",
-		Unicode:   "\\U0001F3C8",
+		Emoji:        "🏈",
+		Shortcode:    ":football:",
+		EmojiVersion: 0.6,
 	},
 	"tennis": {
-		Emoji:     "🎾",
-		Shortcode: ":tennis:",
-		HTML:      "&#x1f3be;",
-		Unicode:   "\\U0001F3BE",
+		Emoji:        "🎾",
+		Shortcode:    ":tennis:",
+		EmojiVersion: 0.6,
 	},
 	"8ball": {
-		Emoji:     "🎱",
-		Shortcode: ":8ball:",
-		HTML:      "&#x1f3b1;",
-		Unicode:   "\\U0001F3B1",
+		Emoji:        "🎱",
+		Shortcode:    ":8ball:",
+		EmojiVersion: 0.6,
 	},
 	"golf": {
-		Emoji:     "⛳",
-		Shortcode: ":golf:",
-		HTML:      "&#x26f3;",
-		Unicode:   "\\U000026F3",
+		Emoji:        "⛳",
+		Shortcode:    ":golf:",
+		EmojiVersion: 0.6,
 	},
 
 	// === TRANSPORTATION ===
 	"car": {
-		Emoji:     "🚗",
-		Shortcode: ":car:",
-		HTML:      "&#x1f697;",
-		Unicode:   "\\U0001F697",
+		Emoji:        "🚗",
+		Shortcode:    ":car:",
+		EmojiVersion: 0.6,
 	},
 	"taxi": {
-		Emoji:     "🚕",
-		Shortcode: ":taxi:",
-		HTML:      "&#x1f695;",
-		Unicode:   "\\U0001F695",
+		Emoji:        "🚕",
+		Shortcode:    ":taxi:",
+		EmojiVersion: 0.6,
 	},
 	"bus": {
-		Emoji:     "🚌",
-		Shortcode: ":bus:",
-		HTML:      "&#x1f68c;",
-		Unicode:   "\\U0001F68C",
+		Emoji:        "🚌",
+		Shortcode:    ":bus:",
+		EmojiVersion: 0.6,
 	},
 	"train": {
-		Emoji:     "🚆",
-		Shortcode: ":train:",
-		HTML:      "&#x1f686;",
-		Unicode:   "\\U0001F686",
+		Emoji:        "🚆",
+		Shortcode:    ":train:",
+		EmojiVersion: 1.0,
 	},
 	"airplane": {
-		Emoji:     "✈️",
-		Shortcode: ":airplane:",
-		HTML:      "&#x2708;&#xfe0f;",
-		Unicode:   "\\U00002708\\uFE0F",
+		Emoji:        "✈️",
+		Shortcode:    ":airplane:",
+		EmojiVersion: 0.6,
 	},
 	"rocket": {
-		Emoji:     "🚀",
-		Shortcode: ":rocket:",
-		HTML:      "&#x1f680;",
-		Unicode:   "\\U0001F680",
+		Emoji:        "🚀",
+		Shortcode:    ":rocket:",
+		EmojiVersion: 0.6,
 	},
 	"ship": {
-		Emoji:     "🚢",
-		Shortcode: ":ship:",
-		HTML:      "&#x1f6a2;",
-		Unicode:   "\\U0001F6A2",
+		Emoji:        "🚢",
+		Shortcode:    ":ship:",
+		EmojiVersion: 0.6,
 	},
 	"bicycle": {
-		Emoji:     "🚲",
-		Shortcode: ":bicycle:",
-		HTML:      "&#x1f6b2;",
-		Unicode:   "\\U0001F6B2",
+		Emoji:        "🚲",
+		Shortcode:    ":bicycle:",
+		EmojiVersion: 0.6,
 	},
 	"scooter": {
-		Emoji:     "🛵",
-		Shortcode: ":scooter:",
-		HTML:      "&#x1f6f5;",
-		Unicode:   "\\U0001F6F5",
+		Emoji:        "🛵",
+		Shortcode:    ":scooter:",
+		EmojiVersion: 3.0,
 	},
 	"motorcycle": {
-		Emoji:     "🏍️",
-		Shortcode: ":motorcycle:",
-		HTML:      "&#x1f3cd;&#xfe0f;",
-		Unicode:   "\\U0001F3CD\\uFE0F",
+		Emoji:        "🏍️",
+		Shortcode:    ":motorcycle:",
+		EmojiVersion: 0.7,
 	},
 	"racing_car": {
-		Emoji:     "🏎️",
-		Shortcode: ":racing_car:",
-		HTML:      "&#x1f3ce;&#xfe0f;",
-		Unicode:   "\\U0001F3CE\\uFE0F",
+		Emoji:        "🏎️",
+		Shortcode:    ":racing_car:",
+		EmojiVersion: 0.7,
 	},
 
 	// === OBJECTS ===
 	"calendar": {
-		Emoji:     "📅",
-		Shortcode: ":calendar:",
-		HTML:      "&#x1f4c5;",
-		Unicode:   "\\U0001F4C5",
+		Emoji:        "📅",
+		Shortcode:    ":calendar:",
+		EmojiVersion: 0.6,
 	},
 	"phone": {
-		Emoji:     "📱",
-		Shortcode: ":phone:",
-		HTML:      "&#x1f4f1;",
-		Unicode:   "\\U0001F4F1",
+		Emoji:        "📱",
+		Shortcode:    ":phone:",
+		EmojiVersion: 0.6,
 	},
 	"computer": {
-		Emoji:     "💻",
-		Shortcode: ":computer:",
-		HTML:      "&#x1f4bb;",
-		Unicode:   "\\U0001F4BB",
+		Emoji:        "💻",
+		Shortcode:    ":computer:",
+		EmojiVersion: 0.6,
 	},
 	"desktop_computer": {
-		Emoji:     "🖥️",
-		Shortcode: ":desktop_computer:",
-		HTML:      "&#x1f5a5;&#xfe0f;",
-		Unicode:   "\\U0001F5A5\\uFE0F",
+		Emoji:        "🖥️",
+		Shortcode:    ":desktop_computer:",
+		EmojiVersion: 0.7,
 	},
 	"floppy_disk": {
-		Emoji:     "💾",
-		Shortcode: ":floppy_disk:",
-		HTML:      "&#x1f4be;",
-		Unicode:   "\\U0001F4BE",
+		Emoji:        "💾",
+		Shortcode:    ":floppy_disk:",
+		EmojiVersion: 0.6,
 	},
 	"keyboard": {
-		Emoji:     "⌨️",
-		Shortcode: ":keyboard:",
-		HTML:      "&#x2328;&#xfe0f;",
-		Unicode:   "\\U00002328\\uFE0F",
+		Emoji:        "⌨️",
+		Shortcode:    ":keyboard:",
+		EmojiVersion: 1.0,
 	},
 	"mouse_three_button": {
-		Emoji:     "🖱️",
-		Shortcode: ":mouse_three_button:",
-		HTML:      "&#x1f5b1;&#xfe0f;",
-		Unicode:   "\\U0001F5B1\\uFE0F",
+		Emoji:        "🖱️",
+		Shortcode:    ":mouse_three_button:",
+		EmojiVersion: 0.7,
 	},
 	"camera": {
-		Emoji:     "📷",
-		Shortcode: ":camera:",
-		HTML:      "&#x1f4f7;",
-		Unicode:   "\\U0001F4F7",
+		Emoji:        "📷",
+		Shortcode:    ":camera:",
+		EmojiVersion: 0.6,
 	},
 	"camera_flash": {
-		Emoji:     "📸",
-		Shortcode: ":camera_flash:",
-		HTML:      "&#x1f4f8;",
-		Unicode:   "\\U0001F4F8",
+		Emoji:        "📸",
+		Shortcode:    ":camera_flash:",
+		EmojiVersion: 1.0,
 	},
 	"tv": {
-		Emoji:     "📺",
-		Shortcode: ":tv:",
-		HTML:      "&#x1f4fa;",
-		Unicode:   "\\U0001F4FA",
+		Emoji:        "📺",
+		Shortcode:    ":tv:",
+		EmojiVersion: 0.6,
 	},
 	"radio": {
-		Emoji:     "📻",
-		Shortcode: ":radio:",
-		HTML:      "&#x1f4fb;",
-		Unicode:   "\\U0001F4FB",
+		Emoji:        "📻",
+		Shortcode:    ":radio:",
+		EmojiVersion: 0.6,
 	},
 	"headphones": {
-		Emoji:     "🎧",
-		Shortcode: ":headphones:",
-		HTML:      "&#x1f3a7;",
-		Unicode:   "\\U0001F3A7",
+		Emoji:        "🎧",
+		Shortcode:    ":headphones:",
+		EmojiVersion: 0.6,
 	},
 	"microphone": {
-		Emoji:     "🎤",
-		Shortcode: ":microphone:",
-		HTML:      "&#x1f3a4;",
-		Unicode:   "\\U0001F3A4",
+		Emoji:        "🎤",
+		Shortcode:    ":microphone:",
+		EmojiVersion: 0.6,
 	},
 	"studio_microphone": {
-		Emoji:     "🎙️",
-		Shortcode: ":studio_microphone:",
-		HTML:      "&#x1f399;&#xfe0f;",
-		Unicode:   "\\U0001F399\\uFE0F",
+		Emoji:        "🎙️",
+		Shortcode:    ":studio_microphone:",
+		EmojiVersion: 0.7,
 	},
 	"chair": {
-		Emoji:     "🪑",
-		Shortcode: ":chair:",
-		HTML:      "&#x1fa91;",
-		Unicode:   "\\U0001FA91",
+		Emoji:        "🪑",
+		Shortcode:    ":chair:",
+		EmojiVersion: 12.0,
 	},
 	"musical_note": {
-		Emoji:     "🎵",
-		Shortcode: ":musical_note:",
-		HTML:      "&#x1f3b5;",
-		Unicode:   "\\U0001F3B5",
+		Emoji:        "🎵",
+		Shortcode:    ":musical_note:",
+		EmojiVersion: 0.6,
 	},
 	"notes": {
-		Emoji:     "🎶",
-		Shortcode: ":notes:",
-		HTML:      "&#x1f3b6;",
-		Unicode:   "\\U0001F3B6",
+		Emoji:        "🎶",
+		Shortcode:    ":notes:",
+		EmojiVersion: 0.6,
 	},
 	"guitar": {
-		Emoji:     "🎸",
-		Shortcode: ":guitar:",
-		HTML:      "&#x1f3b8;",
-		Unicode:   "\\U0001F3B8",
+		Emoji:        "🎸",
+		Shortcode:    ":guitar:",
+		EmojiVersion: 0.6,
 	},
 	"trumpet": {
-		Emoji:     "🎺",
-		Shortcode: ":trumpet:",
-		HTML:      "&#x1f3ba;",
-		Unicode:   "\\U0001F3BA",
+		Emoji:        "🎺",
+		Shortcode:    ":trumpet:",
+		EmojiVersion: 0.6,
 	},
 	"saxophone": {
-		Emoji:     "🎷",
-		Shortcode: ":saxophone:",
-		HTML:      "&#x1f3b7;",
-		Unicode:   "\\U0001F3B7",
+		Emoji:        "🎷",
+		Shortcode:    ":saxophone:",
+		EmojiVersion: 0.6,
+	},
+
+	// === KEYCAPS ===
+	"hash": {
+		Emoji:        "#️⃣",
+		Shortcode:    ":hash:",
+		EmojiVersion: 0.6,
+	},
+	"asterisk": {
+		Emoji:        "*️⃣",
+		Shortcode:    ":asterisk:",
+		EmojiVersion: 2.0,
+	},
+	"zero": {
+		Emoji:        "0️⃣",
+		Shortcode:    ":zero:",
+		EmojiVersion: 0.6,
+	},
+	"one": {
+		Emoji:        "1️⃣",
+		Shortcode:    ":one:",
+		EmojiVersion: 0.6,
+	},
+	"two": {
+		Emoji:        "2️⃣",
+		Shortcode:    ":two:",
+		EmojiVersion: 0.6,
+	},
+	"three": {
+		Emoji:        "3️⃣",
+		Shortcode:    ":three:",
+		EmojiVersion: 0.6,
+	},
+	"four": {
+		Emoji:        "4️⃣",
+		Shortcode:    ":four:",
+		EmojiVersion: 0.6,
+	},
+	"five": {
+		Emoji:        "5️⃣",
+		Shortcode:    ":five:",
+		EmojiVersion: 0.6,
+	},
+	"six": {
+		Emoji:        "6️⃣",
+		Shortcode:    ":six:",
+		EmojiVersion: 0.6,
+	},
+	"seven": {
+		Emoji:        "7️⃣",
+		Shortcode:    ":seven:",
+		EmojiVersion: 0.6,
+	},
+	"eight": {
+		Emoji:        "8️⃣",
+		Shortcode:    ":eight:",
+		EmojiVersion: 0.6,
+	},
+	"nine": {
+		Emoji:        "9️⃣",
+		Shortcode:    ":nine:",
+		EmojiVersion: 0.6,
+	},
+	"keycap_ten": {
+		Emoji:        "🔟",
+		Shortcode:    ":keycap_ten:",
+		EmojiVersion: 0.6,
 	},
 
 	// === FLAGS BY CONTINENT ===
 
 	// === NORTH AMERICA ===
 	"flag_us": {
-		Emoji:     "🇺🇸",
-		Shortcode: ":flag_us:",
-		HTML:      "&#x1f1fa;&#x1f1f8;",
-		Unicode:   "\\U0001F1FA\\U0001F1F8",
+		Emoji:        "🇺🇸",
+		Shortcode:    ":flag_us:",
+		EmojiVersion: 0.6,
 	},
 
 	// === EUROPE ===
 	"flag_gb": {
-		Emoji:     "🇬🇧",
-		Shortcode: ":flag_gb:",
-		HTML:      "&#x1f1ec;&#x1f1e7;",
-		Unicode:   "\\U0001F1EC\\U0001F1E7",
+		Emoji:        "🇬🇧",
+		Shortcode:    ":flag_gb:",
+		EmojiVersion: 0.6,
+	},
+	"flag_gb_eng": {
+		Emoji:        "🏴󠁧󠁢󠁥󠁮󠁧󠁿",
+		Shortcode:    ":flag_gb_eng:",
+		EmojiVersion: 5.0,
+	},
+	"flag_gb_sct": {
+		Emoji:        "🏴󠁧󠁢󠁳󠁣󠁴󠁿",
+		Shortcode:    ":flag_gb_sct:",
+		EmojiVersion: 5.0,
+	},
+	"flag_gb_wls": {
+		Emoji:        "🏴󠁧󠁢󠁷󠁬󠁳󠁿",
+		Shortcode:    ":flag_gb_wls:",
+		EmojiVersion: 5.0,
 	},
 	"flag_fr": {
-		Emoji:     "🇫🇷",
-		Shortcode: ":flag_fr:",
-		HTML:      "&#x1f1eb;&#x1f1f7;",
-		Unicode:   "\\U0001F1EB\\U0001F1F7",
+		Emoji:        "🇫🇷",
+		Shortcode:    ":flag_fr:",
+		EmojiVersion: 0.6,
 	},
 	"flag_it": {
-		Emoji:     "🇮🇹",
-		Shortcode: ":flag_it:",
-		HTML:      "&#x1f1ee;&#x1f1f9;",
-		Unicode:   "\\U0001F1EE\\U0001F1F9",
+		Emoji:        "🇮🇹",
+		Shortcode:    ":flag_it:",
+		EmojiVersion: 0.6,
 	},
 	"flag_de": {
-		Emoji:     "🇩🇪",
-		Shortcode: ":flag_de:",
-		HTML:      "&#x1f1e9;&#x1f1ea;",
-		Unicode:   "\\U0001F1E9\\U0001F1EA",
+		Emoji:        "🇩🇪",
+		Shortcode:    ":flag_de:",
+		EmojiVersion: 0.6,
 	},
 	"flag_es": {
-		Emoji:     "🇪🇦",
-		Shortcode: ":flag_es:",
-		HTML:      "&#x1f1ea;&#x1f1f8;",
-		Unicode:   "\\U0001F1EA\\U0001F1F8",
+		Emoji:        "🇪🇸",
+		Shortcode:    ":flag_es:",
+		EmojiVersion: 0.6,
 	},
 
 	// === ASIA ===
 	"flag_jp": {
-		Emoji:     "🇯🇵",
-		Shortcode: ":flag_jp:",
-		HTML:      "&#x1f1ef;&#x1f1f5;",
-		Unicode:   "\\U0001F1EF\\U0001F1F5",
+		Emoji:        "🇯🇵",
+		Shortcode:    ":flag_jp:",
+		EmojiVersion: 0.6,
 	},
 	"flag_cn": {
-		Emoji:     "🇨🇳",
-		Shortcode: ":flag_cn:",
-		HTML:      "&#x1f1e8;&#x1f1f3;",
-		Unicode:   "\\U0001F1E8\\U0001F1F3",
+		Emoji:        "🇨🇳",
+		Shortcode:    ":flag_cn:",
+		EmojiVersion: 0.6,
 	},
 
 	// === SOUTH AMERICA ===
 	"flag_co": {
-		Emoji:     "🇨🇴",
-		Shortcode: ":flag_co:",
-		HTML:      "&#x1f1e8;&#x1f1f4;",
-		Unicode:   "\\U0001F1E8\\U0001F1F4",
+		Emoji:        "🇨🇴",
+		Shortcode:    ":flag_co:",
+		EmojiVersion: 2.0,
 	},
 	"flag_ar": {
-		Emoji:     "🇦🇷",
-		Shortcode: ":flag_ar:",
-		HTML:      "&#x1f1e6;&#x1f1f7;",
-		Unicode:   "\\U0001F1E6\\U0001F1F7",
+		Emoji:        "🇦🇷",
+		Shortcode:    ":flag_ar:",
+		EmojiVersion: 2.0,
 	},
 	"flag_mx": {
-		Emoji:     "🇲🇽",
-		Shortcode: ":flag_mx:",
-		HTML:      "&#x1f1f2;&#x1f1fd;",
-		Unicode:   "\\U0001F1F2\\U0001F1FD",
+		Emoji:        "🇲🇽",
+		Shortcode:    ":flag_mx:",
+		EmojiVersion: 2.0,
 	},
 	"flag_br": {
-		Emoji:     "🇧🇷",
-		Shortcode: ":flag_br:",
-		HTML:      "&#x1f1e7;&#x1f1f7;",
-		Unicode:   "\\U0001F1E7\\U0001F1F7",
+		Emoji:        "🇧🇷",
+		Shortcode:    ":flag_br:",
+		EmojiVersion: 2.0,
 	},
 }
+
+// Alternative shortcodes accepted for some emojis, keyed by emoji name.
+// These are the names other platforms (GitHub, Slack) commonly use.
+var emojiAliases = map[string][]string{
+	"grinning_eyes":      {"grin"},
+	"laughing":           {"satisfied"},
+	"joy":                {"face_with_tears_of_joy"},
+	"slight_smile":       {"slightly_smiling_face"},
+	"upside_down":        {"upside_down_face"},
+	"thumbs_up":          {"thumbsup", "+1"},
+	"thumbs_down":        {"thumbsdown", "-1"},
+	"clap":               {"clapping_hands"},
+	"pray":               {"folded_hands"},
+	"punch":              {"facepunch"},
+	"fist":               {"fist_raised"},
+	"raised_hand":        {"hand"},
+	"hand_splayed":       {"raised_hand_with_fingers_splayed"},
+	"peace":              {"v"},
+	"vulcan":             {"vulcan_salute"},
+	"call_me":            {"call_me_hand"},
+	"metal":              {"sign_of_the_horns"},
+	"cupid":              {"heart_with_arrow"},
+	"lion_face":          {"lion"},
+	"panda_face":         {"panda"},
+	"star2":              {"glowing_star"},
+	"boom":               {"collision"},
+	"zap":                {"high_voltage"},
+	"sun":                {"sunny"},
+	"moon":               {"crescent_moon"},
+	"orange":             {"tangerine"},
+	"coffee":             {"hot_beverage"},
+	"soccer":             {"soccer_ball"},
+	"football":           {"american_football"},
+	"car":                {"red_car"},
+	"scooter":            {"motor_scooter"},
+	"phone":              {"iphone"},
+	"computer":           {"laptop"},
+	"mouse_three_button": {"computer_mouse"},
+	"camera_flash":       {"camera_with_flash"},
+	"headphones":         {"headphone"},
+	"notes":              {"musical_notes"},
+	"hash":               {"number_sign", "keycap_hash"},
+	"asterisk":           {"keycap_star", "keycap_asterisk"},
+	"keycap_ten":         {"ten"},
+	"flag_gb_eng":        {"england"},
+	"flag_gb_sct":        {"scotland"},
+	"flag_gb_wls":        {"wales"},
+	"person":             {"adult"},
+	"person_shrugging":   {"shrug"},
+	"person_facepalming": {"facepalm"},
+}
+
+// Emoji names ordered by how often the emojis are used, most popular first,
+// following the Unicode Consortium's emoji frequency data. Emojis that are not
+// listed rank after all listed ones.
+var emojiPopularity = []string{
+	"joy", "heart", "thumbs_up", "sob", "pray", "kissing_heart", "heart_eyes", "blush",
+	"grinning_eyes", "two_hearts", "sweat_smile", "fire", "relaxed", "laughing", "wink",
+	"thinking", "clap", "slight_smile", "flushed", "sunglasses", "ok_hand", "purple_heart",
+	"sparkles", "sparkling_heart", "eyes", "yum", "cry", "point_right", "heartpulse", "weary",
+	"rose", "blue_heart", "smiley", "rage", "see_no_evil", "crossed_fingers", "smile",
+	"raised_hands", "grinning", "broken_heart", "upside_down", "scream", "neutral_face",
+	"sun_with_face", "cherry_blossom", "notes", "peace", "green_heart", "sun", "black_heart",
+	"kissing_closed_eyes", "boom", "frowning", "expressionless", "point_left", "wave",
+	"triumph", "star2", "rainbow", "yellow_heart", "tired_face", "punch", "confused", "zap",
+	"coffee", "four_leaf_clover", "sweat_drops", "star", "hibiscus", "metal", "tulip", "cupid",
+	"beers", "worried", "persevere", "angry", "moon", "call_me", "speak_no_evil",
+}
+
+// ASCII emoticons recognized as emojis, keyed by emoji name. The first
+// emoticon of each emoji is the one produced by FormatEmoticon.
+var emojiEmoticons = map[string][]string{
+	"blush":             {":)", ":-)", "=)", "(:"},
+	"smiley":            {":D", ":-D", "=D"},
+	"laughing":          {"xD", "XD"},
+	"wink":              {";)", ";-)"},
+	"joy":               {":')", ":'-)"},
+	"slightly_frowning": {":(", ":-(", "=("},
+	"cry":               {":'(", ":'-("},
+	"angry":             {">:(", ">:-("},
+	"open_mouth":        {":o", ":O", ":-o", ":-O"},
+	"confused":          {":/", ":-/", ":\\", ":-\\"},
+	"neutral_face":      {":|", ":-|"},
+	"expressionless":    {"-_-"},
+	"yum":               {":P", ":-P", ":p", ":-p"},
+	"kissing":           {":*", ":-*"},
+	"flushed":           {":$", ":-$"},
+	"sunglasses":        {"B-)", "8-)"},
+	"heart":             {"<3"},
+	"broken_heart":      {"</3"},
+}
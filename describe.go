@@ -0,0 +1,24 @@
+package gomoji
+
+import "context"
+
+// Describe replaces every emoji in text with its CLDR short name wrapped in
+// the given delimiters, so screen readers and text-to-speech engines read
+// emojis by their meaning.
+//
+// Emojis in any supported format are recognized, as in TransformText. Use
+// TransformText with FormatDescription to replace emojis without delimiters.
+//
+// Example:
+//
+//	text := Describe(ctx, "Great job 👍!", "[", "]")
+//	// text: "Great job [thumbs up]!"
+func Describe(ctx context.Context, text, prefix, suffix string) string {
+	return joinSegments(replaceEmojis(ctx, text, Options{}, func(name string) (string, error) {
+		description, err := Transform(name, FormatDescription)
+		if err != nil {
+			return "", err
+		}
+		return prefix + description + suffix, nil
+	}))
+}
@@ -0,0 +1,69 @@
+package gomoji
+
+import (
+	"context"
+	"testing"
+)
+
+func TestDescribe(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		prefix   string
+		suffix   string
+		expected string
+	}{
+		{
+			name:     "square brackets",
+			input:    "Great job 👍!",
+			prefix:   "[",
+			suffix:   "]",
+			expected: "Great job [thumbs up]!",
+		},
+		{
+			name:     "mixed formats",
+			input:    "I'm 😄 and :fire: &#x2764;&#xfe0f;",
+			prefix:   "(",
+			suffix:   ")",
+			expected: "I'm (grinning face with smiling eyes) and (fire) (red heart)",
+		},
+		{
+			name:     "no delimiters",
+			input:    "Flag 🇨🇴",
+			prefix:   "",
+			suffix:   "",
+			expected: "Flag flag: Colombia",
+		},
+		{
+			name:     "no emojis",
+			input:    "plain text",
+			prefix:   "[",
+			suffix:   "]",
+			expected: "plain text",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := Describe(context.Background(), tt.input, tt.prefix, tt.suffix)
+			if result != tt.expected {
+				t.Errorf("Describe(%q) = %q, expected %q", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestTransformDescription(t *testing.T) {
+	result, err := Transform(":studio_microphone:", FormatDescription)
+	if err != nil {
+		t.Fatalf("Transform returned error: %v", err)
+	}
+	if result != "studio microphone" {
+		t.Errorf("Transform(:studio_microphone:, FormatDescription) = %q, expected %q", result, "studio microphone")
+	}
+
+	text := TransformText(context.Background(), "Hello 😊", FormatDescription)
+	if text != "Hello smiling face with smiling eyes" {
+		t.Errorf("TransformText = %q, expected %q", text, "Hello smiling face with smiling eyes")
+	}
+}
@@ -0,0 +1,88 @@
+package gomoji
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/Santiago-Balcero/gobserve"
+	"go.uber.org/zap"
+)
+
+// urlRegex matches URLs, which are never scanned for emoticons so that
+// "http://" or "example.com/:D" are left untouched.
+var urlRegex = regexp.MustCompile(`(?i)\b(?:[a-z][a-z0-9+.\-]*://|www\.)[^\s<>"']+`)
+
+// replaceEmoticons replaces every standalone emoticon in text with the result
+// of replace for the emoji's name.
+//
+// An emoticon is only recognized when it starts the text or follows
+// whitespace, and ends the text or is followed by whitespace or one of
+// ".,!?;". Emoticons inside URLs are never recognized.
+func replaceEmoticons(ctx context.Context, text string, replace func(name string) (string, error)) []segment {
+	protected := urlRegex.FindAllStringIndex(text, -1)
+
+	var b segmentBuilder
+	last := 0
+	for i := 0; i < len(text); {
+		// Skip URLs
+		for len(protected) > 0 && protected[0][1] <= i {
+			protected = protected[1:]
+		}
+		if len(protected) > 0 && i >= protected[0][0] {
+			i = protected[0][1]
+			protected = protected[1:]
+			continue
+		}
+
+		if emoticon := emoticonAt(text, i); emoticon != "" {
+			name := emoticonToName[emoticon]
+			transformed, err := replace(name)
+			if err == nil {
+				b.keep(text[last:i])
+				b.replace(transformed)
+				i += len(emoticon)
+				last = i
+				continue
+			}
+			gobserve.AddLogFields(
+				ctx,
+				zap.Error(fmt.Errorf("transformation for emoticon %q with name %q failed: %w", emoticon, name, err)),
+			)
+		}
+
+		_, size := utf8.DecodeRuneInString(text[i:])
+		i += size
+	}
+	b.keep(text[last:])
+	return b.segments
+}
+
+// emoticonAt returns the longest standalone emoticon starting at byte offset i
+// of text, or an empty string if there is none.
+func emoticonAt(text string, i int) string {
+	if i > 0 {
+		previous, _ := utf8.DecodeLastRuneInString(text[:i])
+		if !unicode.IsSpace(previous) {
+			return ""
+		}
+	}
+
+	for _, emoticon := range emoticonsByLength {
+		if !strings.HasPrefix(text[i:], emoticon) {
+			continue
+		}
+		end := i + len(emoticon)
+		if end == len(text) {
+			return emoticon
+		}
+		next, _ := utf8.DecodeRuneInString(text[end:])
+		if unicode.IsSpace(next) || strings.ContainsRune(".,!?;", next) {
+			return emoticon
+		}
+	}
+	return ""
+}
@@ -0,0 +1,112 @@
+package gomoji
+
+import (
+	"context"
+	"testing"
+)
+
+func TestTransformEmoticon(t *testing.T) {
+	tests := []struct {
+		name         string
+		input        string
+		targetFormat Format
+		expected     string
+	}{
+		{"smile emoticon to emoji", ":)", FormatEmoji, "😊"},
+		{"big grin to emoji", ":-D", FormatEmoji, "😃"},
+		{"heart to emoji", "<3", FormatEmoji, "❤️"},
+		{"wink to shortcode", ";)", FormatShortcode, ":wink:"},
+		{"emoji to emoticon", "😉", FormatEmoticon, ";)"},
+		{"shortcode to emoticon", ":broken_heart:", FormatEmoticon, "</3"},
+		{"emoticon fallback to shortcode", "🔥", FormatEmoticon, ":fire:"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Transform(tt.input, tt.targetFormat)
+			if err != nil {
+				t.Fatalf("Transform(%s) returned error: %v", tt.input, err)
+			}
+			if result != tt.expected {
+				t.Errorf("Transform(%s) = %s, expected %s", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestTransformTextEmoticons(t *testing.T) {
+	tests := []struct {
+		name         string
+		input        string
+		targetFormat Format
+		opts         Options
+		expected     string
+	}{
+		{
+			name:         "emoticons are opt-in",
+			input:        "Nice :) <3",
+			targetFormat: FormatEmoji,
+			opts:         Options{},
+			expected:     "Nice :) <3",
+		},
+		{
+			name:         "emoticons to emoji",
+			input:        "Nice :) <3",
+			targetFormat: FormatEmoji,
+			opts:         Options{Emoticons: true},
+			expected:     "Nice 😊 ❤️",
+		},
+		{
+			name:         "longest emoticon wins",
+			input:        "grr >:( and :(",
+			targetFormat: FormatShortcode,
+			opts:         Options{Emoticons: true},
+			expected:     "grr :angry: and :slightly_frowning:",
+		},
+		{
+			name:         "punctuation after emoticon",
+			input:        "See you ;)!",
+			targetFormat: FormatShortcode,
+			opts:         Options{Emoticons: true},
+			expected:     "See you :wink:!",
+		},
+		{
+			name:         "urls are protected",
+			input:        "Docs at http://example.com/:D and https://x.io/a:/b :/",
+			targetFormat: FormatEmoji,
+			opts:         Options{Emoticons: true},
+			expected:     "Docs at http://example.com/:D and https://x.io/a:/b 😕",
+		},
+		{
+			name:         "emoticons inside words are ignored",
+			input:        "ratio 3:2, time 10:30, xDebug, a:)",
+			targetFormat: FormatEmoji,
+			opts:         Options{Emoticons: true},
+			expected:     "ratio 3:2, time 10:30, xDebug, a:)",
+		},
+		{
+			name:         "emoji to emoticon",
+			input:        "Hi 😊 and 🔥",
+			targetFormat: FormatEmoticon,
+			opts:         Options{},
+			expected:     "Hi :) and :fire:",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := TransformTextWithOptions(context.Background(), tt.input, tt.targetFormat, tt.opts)
+			if result != tt.expected {
+				t.Errorf("TransformTextWithOptions(%q) = %q, expected %q", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestEmoticonNames(t *testing.T) {
+	for name := range emojiEmoticons {
+		if _, exists := emojiMappings[name]; !exists {
+			t.Errorf("emoticon target %q is not a supported emoji", name)
+		}
+	}
+}
@@ -0,0 +1,50 @@
+package gomoji
+
+import "strings"
+
+// CanonicalKey returns a key identifying the emoji given in any supported
+// format, ignoring variation selectors, so that every representation of the
+// same emoji gets the same key. The key is the fully-qualified emoji.
+// It returns false if the input is not a supported emoji.
+//
+// Example:
+//
+//	key, ok := CanonicalKey("&#x1f44d;")
+//	// key: "👍", ok: true
+func CanonicalKey(input string) (string, bool) {
+	name := canonicalName(input)
+	if name == "" {
+		return "", false
+	}
+	return emojiMappings[name].Emoji, true
+}
+
+// Equal reports whether a and b are the same supported emoji, in any
+// supported format and ignoring variation selectors. Unsupported inputs are
+// never equal, not even to themselves.
+//
+// Example:
+//
+//	Equal("👍", ":thumbs_up:")  // true
+//	Equal("👍️", "&#x1f44d;") // true
+func Equal(a, b string) bool {
+	nameA := canonicalName(a)
+	return nameA != "" && nameA == canonicalName(b)
+}
+
+// canonicalName resolves input as findEmojiName does, also accepting encoded
+// emojis with missing or extra variation selectors, such as "&#x1f44d;&#xfe0f;".
+func canonicalName(input string) string {
+	if name := findEmojiName(input); name != "" {
+		return name
+	}
+	input = strings.TrimSpace(input)
+	for _, decode := range encodingDecoders() {
+		if codepoints, ok := decode(input); ok {
+			if name, exists := lookupEmoji(string(codepoints)); exists {
+				return name
+			}
+		}
+	}
+	return ""
+}
@@ -0,0 +1,65 @@
+package gomoji
+
+import "testing"
+
+func TestEqual(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected bool
+	}{
+		{"👍", "👍\uFE0F", true},
+		{"👍", ":thumbs_up:", true},
+		{"👍\uFE0F", "&#x1f44d;", true},
+		{"&#x1f44d;&#xfe0f;", "\U0001F44D", true},
+		{"\u2764", "❤\uFE0F", true},
+		{"\u2764\uFE0E", ":heart:", true},
+		{"&#x2764;&#xfe0e;", "heart", true},
+		{"<3", "\u2764", true},
+		{"U+1F44D", "thumbs_up", true},
+		{"👍", "👎", false},
+		{"👍", "not an emoji", false},
+		{"not an emoji", "not an emoji", false},
+	}
+
+	for _, tt := range tests {
+		if result := Equal(tt.a, tt.b); result != tt.expected {
+			t.Errorf("Equal(%q, %q) = %v, expected %v", tt.a, tt.b, result, tt.expected)
+		}
+		if result := Equal(tt.b, tt.a); result != tt.expected {
+			t.Errorf("Equal(%q, %q) = %v, expected %v", tt.b, tt.a, result, tt.expected)
+		}
+	}
+}
+
+func TestCanonicalKey(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+		ok       bool
+	}{
+		{"👍", "👍", true},
+		{"👍\uFE0F", "👍", true},
+		{"&#x1f44d;&#xfe0f;", "👍", true},
+		{"\u2764", "\u2764\uFE0F", true},
+		{"&#x2764;&#xfe0e;", "\u2764\uFE0F", true},
+		{":heart:", "\u2764\uFE0F", true},
+		{"#\u20E3", "#\uFE0F\u20E3", true},
+		{"not an emoji", "", false},
+	}
+
+	for _, tt := range tests {
+		key, ok := CanonicalKey(tt.input)
+		if key != tt.expected || ok != tt.ok {
+			t.Errorf("CanonicalKey(%q) = %q, %v, expected %q, %v", tt.input, key, ok, tt.expected, tt.ok)
+		}
+	}
+
+	// Every representation of an emoji has the same key
+	for name, mapping := range emojiMappings {
+		for _, input := range []string{name, mapping.Emoji, mapping.Shortcode, mapping.HTML, mapping.Unicode} {
+			if key, ok := CanonicalKey(input); !ok || key != mapping.Emoji {
+				t.Errorf("CanonicalKey(%q) = %q, %v, expected %q", input, key, ok, mapping.Emoji)
+			}
+		}
+	}
+}
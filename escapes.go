@@ -0,0 +1,197 @@
+package gomoji
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/Santiago-Balcero/gobserve"
+	"go.uber.org/zap"
+	"golang.org/x/text/unicode/runenames"
+)
+
+// maxEmojiRunes is the longest emoji sequence, in code points, that text
+// scanning tries to match.
+const maxEmojiRunes = 16
+
+// Regexes for runs of language-specific escapes, and for a single escape
+// within a run.
+var (
+	rustEscapeRegex   = regexp.MustCompile(`(?:\\u\{[0-9a-fA-F]{1,6}\})+`)
+	rustPartRegex     = regexp.MustCompile(`\\u\{([0-9a-fA-F]{1,6})\}`)
+	cssEscapeRegex    = regexp.MustCompile(`(?:\\[0-9a-fA-F]{1,6} ?)+`)
+	cssPartRegex      = regexp.MustCompile(`\\([0-9a-fA-F]{1,6}) ?`)
+	pythonEscapeRegex = regexp.MustCompile(`(?:\\N\{[^{}\\]+\})+`)
+	pythonPartRegex   = regexp.MustCompile(`\\N\{([^{}\\]+)\}`)
+)
+
+// pythonNameToRunes maps uppercase Unicode character names, and the CLDR
+// short names of emojis, to their code points
+var pythonNameToRunes map[string][]rune
+
+// escapedRune is a code point decoded from escapes, with the escape text it
+// came from and the separator text that follows it. r is negative for an
+// unpaired surrogate or an invalid code point. When one escape decodes to
+// several code points, the first one holds the source.
+type escapedRune struct {
+	r      rune
+	source string
+	sep    string
+}
+
+// encodeRustEscape encodes s as Rust \u{XXXX} escapes.
+func encodeRustEscape(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		fmt.Fprintf(&b, "\\u{%X}", r)
+	}
+	return b.String()
+}
+
+// encodeCSSEscape encodes s as CSS escapes. Each escape is terminated by a
+// space, so the following character is never read as part of it.
+func encodeCSSEscape(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		fmt.Fprintf(&b, "\\%X ", r)
+	}
+	return b.String()
+}
+
+// encodePythonEscape encodes s as Python \N{NAME} escapes, using the Unicode
+// character name of each code point.
+func encodePythonEscape(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		fmt.Fprintf(&b, "\\N{%s}", runenames.Name(r))
+	}
+	return b.String()
+}
+
+// parseRustEscapes decodes a run of \u{XXXX} escapes into code points.
+func parseRustEscapes(run string) []escapedRune {
+	return parseHexEscapes(run, rustPartRegex)
+}
+
+// parseCSSEscapes decodes a run of \XXXX escapes, each ending at an optional space.
+func parseCSSEscapes(run string) []escapedRune {
+	return parseHexEscapes(run, cssPartRegex)
+}
+
+// parsePythonEscapes decodes a run of \N{NAME} escapes. Names are matched
+// case-insensitively against Unicode character names and CLDR short names.
+func parsePythonEscapes(run string) []escapedRune {
+	var items []escapedRune
+	for _, m := range pythonPartRegex.FindAllStringSubmatch(run, -1) {
+		runes := pythonNameToRunes[strings.ToUpper(strings.TrimSpace(m[1]))]
+		if len(runes) == 0 {
+			items = append(items, escapedRune{r: -1, source: m[0]})
+			continue
+		}
+		items = append(items, escapedRune{r: runes[0], source: m[0]})
+		for _, r := range runes[1:] {
+			items = append(items, escapedRune{r: r})
+		}
+	}
+	return items
+}
+
+// parseHexEscapes decodes a run of escapes holding a hexadecimal code point
+// in the first matching group of part, keeping the text between escapes as
+// separators and marking invalid code points.
+func parseHexEscapes(run string, part *regexp.Regexp) []escapedRune {
+	matches := part.FindAllStringSubmatchIndex(run, -1)
+	items := make([]escapedRune, 0, len(matches))
+	for k, m := range matches {
+		end := len(run)
+		if k+1 < len(matches) {
+			end = matches[k+1][0]
+		}
+
+		group := 2
+		for group+2 < len(m) && m[group] < 0 {
+			group += 2
+		}
+
+		r := rune(-1)
+		if value, err := strconv.ParseUint(run[m[group]:m[group+1]], 16, 32); err == nil && utf8.ValidRune(rune(value)) {
+			r = rune(value)
+		}
+		items = append(items, escapedRune{r: r, source: run[m[0]:m[1]], sep: run[m[1]:end]})
+	}
+	return items
+}
+
+// decodeEscapes decodes a string made only of escapes matched by re. It
+// fails if the input contains anything else or an invalid code point.
+func decodeEscapes(s string, re *regexp.Regexp, parse func(run string) []escapedRune) (string, bool) {
+	if s == "" || re.FindString(s) != s {
+		return "", false
+	}
+
+	items := parse(s)
+	runes := make([]rune, 0, len(items))
+	for _, item := range items {
+		if item.r < 0 {
+			return "", false
+		}
+		runes = append(runes, item.r)
+	}
+	return string(runes), true
+}
+
+// replaceEscapedRunes greedily matches the longest known emoji at each
+// position of a decoded escape run and replaces it. Separators inside a
+// replaced emoji are dropped; all others are kept.
+func replaceEscapedRunes(ctx context.Context, items []escapedRune, replace func(name string) (string, error)) []segment {
+	var b segmentBuilder
+	for i := 0; i < len(items); {
+		matched := false
+		for j := min(len(items), i+maxEmojiRunes); j > i; j-- {
+			name, ok := lookupEscapedRunes(items[i:j])
+			if !ok {
+				continue
+			}
+
+			var source strings.Builder
+			for _, item := range items[i:j] {
+				source.WriteString(item.source + item.sep)
+			}
+			transformed, err := replace(name)
+			if err != nil {
+				gobserve.AddLogFields(
+					ctx,
+					zap.Error(fmt.Errorf("transformation for escape %q with name %q failed: %w", source.String(), name, err)),
+				)
+				break
+			}
+			b.replace(transformed)
+			b.keep(items[j-1].sep)
+			i = j
+			matched = true
+			break
+		}
+
+		if !matched {
+			b.keep(items[i].source + items[i].sep)
+			i++
+		}
+	}
+	return b.segments
+}
+
+// lookupEscapedRunes resolves decoded escapes to an emoji name. Sequences
+// with invalid code points never match.
+func lookupEscapedRunes(items []escapedRune) (string, bool) {
+	runes := make([]rune, 0, len(items))
+	for _, item := range items {
+		if item.r < 0 {
+			return "", false
+		}
+		runes = append(runes, item.r)
+	}
+	return lookupEmoji(string(runes))
+}
@@ -0,0 +1,145 @@
+package gomoji
+
+import (
+	"context"
+	"testing"
+)
+
+func TestTransformLanguageEscapes(t *testing.T) {
+	tests := []struct {
+		name         string
+		input        string
+		targetFormat Format
+		expected     string
+	}{
+		{"emoji to rust", "😄", FormatRust, `\u{1F604}`},
+		{"sequence to rust", "🎙️", FormatRust, `\u{1F399}\u{FE0F}`},
+		{"rust to emoji", `\u{1f604}`, FormatEmoji, "😄"},
+		{"emoji to css", "😄", FormatCSS, `\1F604 `},
+		{"sequence to css", "🎙️", FormatCSS, `\1F399 \FE0F `},
+		{"css to emoji", `\1F399 \FE0F `, FormatEmoji, "🎙️"},
+		{"css without trailing space", `\1F604`, FormatShortcode, ":smile:"},
+		{"emoji to python", "😄", FormatPython, `\N{SMILING FACE WITH OPEN MOUTH AND SMILING EYES}`},
+		{"sequence to python", "🎙️", FormatPython, `\N{STUDIO MICROPHONE}\N{VARIATION SELECTOR-16}`},
+		{"python to emoji", `\N{smiling face with open mouth and smiling eyes}`, FormatEmoji, "😄"},
+		{"python uses unicode names", `\N{GRINNING FACE WITH SMILING EYES}`, FormatEmoji, "😁"},
+		{"python with CLDR name", `\N{red heart}`, FormatEmoji, "❤️"},
+		{"python with multi-code-point CLDR name", `\N{woman teacher: medium skin tone}`, FormatEmoji, "👩🏽‍🏫"},
+		{"emoji to java", "😄", FormatJava, `\uD83D\uDE04`},
+		{"java to emoji", `\uD83D\uDE04`, FormatEmoji, "😄"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Transform(tt.input, tt.targetFormat)
+			if err != nil {
+				t.Fatalf("Transform(%s) returned error: %v", tt.input, err)
+			}
+			if result != tt.expected {
+				t.Errorf("Transform(%s) = %s, expected %s", tt.input, result, tt.expected)
+			}
+		})
+	}
+
+	for _, input := range []string{`\u{D83D}`, `\u{110000}`, `\N{NOT A CHARACTER}`, `\41`} {
+		if IsSupported(input) {
+			t.Errorf("IsSupported(%s) = true, expected false", input)
+		}
+	}
+}
+
+func TestTransformTextLanguageEscapes(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name         string
+		input        string
+		targetFormat Format
+		expected     string
+	}{
+		{
+			name:         "windows path",
+			input:        `see C:\temp\2708 now`,
+			targetFormat: FormatShortcode,
+			expected:     `see C:\temp\2708 now`,
+		},
+		{
+			name:         "escape-like text",
+			input:        `price \26A1 deal`,
+			targetFormat: FormatShortcode,
+			expected:     `price \26A1 deal`,
+		},
+		{
+			name:         "emoji to css",
+			input:        "Mic 🎙️!",
+			targetFormat: FormatCSS,
+			expected:     `Mic \1F399 \FE0F !`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := TransformText(ctx, tt.input, tt.targetFormat)
+			if result != tt.expected {
+				t.Errorf("TransformText(%q) = %q, expected %q", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestTransformTextEscapesOption(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name         string
+		input        string
+		targetFormat Format
+		expected     string
+	}{
+		{
+			name:         "rust string",
+			input:        `println!("hi \u{1F604}\u{1F525}");`,
+			targetFormat: FormatShortcode,
+			expected:     `println!("hi :smile::fire:");`,
+		},
+		{
+			name:         "css content",
+			input:        `a::before { content: "\1F604 "; }`,
+			targetFormat: FormatEmoji,
+			expected:     `a::before { content: "😄"; }`,
+		},
+		{
+			name:         "python string",
+			input:        `print("\N{STUDIO MICROPHONE}\N{VARIATION SELECTOR-16} \N{LATIN SMALL LETTER A}")`,
+			targetFormat: FormatShortcode,
+			expected:     `print(":studio_microphone: \N{LATIN SMALL LETTER A}")`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := TransformTextWithOptions(ctx, tt.input, tt.targetFormat, Options{Escapes: true})
+			if result != tt.expected {
+				t.Errorf("TransformTextWithOptions(%q) = %q, expected %q", tt.input, result, tt.expected)
+			}
+			if result := TransformText(ctx, tt.input, tt.targetFormat); result != tt.input {
+				t.Errorf("TransformText(%q) = %q, expected it unchanged", tt.input, result)
+			}
+		})
+	}
+}
+
+func TestEncodeLanguageEscapesRoundTrip(t *testing.T) {
+	formats := []Format{FormatRust, FormatCSS, FormatPython, FormatJava}
+	for name := range emojiMappings {
+		for _, format := range formats {
+			encoded, err := Transform(name, format)
+			if err != nil {
+				t.Fatalf("Transform(%s, %s) returned error: %v", name, format, err)
+			}
+			if found := findEmojiName(encoded); found != name {
+				t.Errorf("%s: %s output %s resolves to %q", name, format, encoded, found)
+			}
+		}
+	}
+}
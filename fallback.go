@@ -0,0 +1,200 @@
+package gomoji
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// Regexes for runs of HTML character references and unicode escapes, and for
+// a single one within a run, used to decode emojis missing from the table
+var (
+	htmlEscapeRegex    = regexp.MustCompile(`(?:&#x[0-9a-fA-F]{1,6};)+`)
+	htmlPartRegex      = regexp.MustCompile(`&#x([0-9a-fA-F]{1,6});`)
+	unicodeEscapeRegex = regexp.MustCompile(`(?:\\U[0-9a-fA-F]{8}|\\u[0-9a-fA-F]{4})+`)
+	unicodePartRegex   = regexp.MustCompile(`\\U([0-9a-fA-F]{8})|\\u([0-9a-fA-F]{4})`)
+)
+
+// TransformWithOptions is like Transform, with options controlling how the
+// input is resolved.
+//
+// With Options.Fallback, a valid emoji sequence missing from the table, such
+// as an emoji from a newer Unicode version, can still be converted between
+// the encoding formats: emoji, HTML, unicode and the escape formats.
+//
+// Example:
+//
+//	html, err := TransformWithOptions("🫨", FormatHTML, Options{Fallback: true})
+//	// html: "&#x1fae8;"
+func TransformWithOptions(input string, targetFormat Format, opts Options) (string, error) {
+	locales, err := matchLocales(opts.Locales)
+	if err != nil {
+		return "", err
+	}
+
+	result, err := transformLocalized(input, targetFormat, locales)
+	if err == nil || !opts.Fallback {
+		return result, err
+	}
+
+	var notFound *NotFoundError
+	if !errors.As(err, &notFound) {
+		return "", err
+	}
+	codepoints, ok := decodeFallback(strings.TrimSpace(input))
+	if !ok {
+		return "", err
+	}
+	if encoded, ok := encodeFallback(codepoints, targetFormat, opts); ok {
+		return encoded, nil
+	}
+	return "", err
+}
+
+// decodeFallback decodes input written as an emoji or in any encoding format,
+// without requiring it to be in the table. It fails unless input is a single
+// valid emoji sequence.
+func decodeFallback(input string) ([]rune, bool) {
+	if isEmojiSequence(input) {
+		return []rune(input), true
+	}
+
+	for _, decode := range encodingDecoders() {
+		if codepoints, ok := decode(input); ok && isEmojiSequence(string(codepoints)) {
+			return codepoints, true
+		}
+	}
+	return nil, false
+}
+
+// encodingDecoders returns the decoders of the HTML and unicode formats and
+// of every escape format and format added with RegisterFormat.
+func encodingDecoders() []func(string) ([]rune, bool) {
+	decoders := []func(string) ([]rune, bool){
+		func(s string) ([]rune, bool) { return decodeRunes(s, htmlEscapeRegex, parseHTMLEscapes) },
+		func(s string) ([]rune, bool) { return decodeRunes(s, unicodeEscapeRegex, parseUnicodeEscapes) },
+	}
+	for _, codec := range registeredCodecs() {
+		decoders = append(decoders, codec.Decode)
+	}
+	return decoders
+}
+
+// encodeFallback encodes code points in one of the encoding formats. It fails
+// for formats that need the emoji table, such as shortcodes.
+func encodeFallback(codepoints []rune, format Format, opts Options) (string, bool) {
+	switch format {
+	case FormatEmoji:
+		return string(codepoints), true
+	case FormatHTML:
+		return encodeHTML(string(codepoints)), true
+	case FormatUnicode:
+		return encodeUnicode(string(codepoints)), true
+	case FormatCodepoint:
+		if opts.CodepointSeparator != "" {
+			return encodeCodepoint(string(codepoints), opts.CodepointSeparator), true
+		}
+	}
+
+	if codec, ok := lookupCodec(format); ok {
+		return codec.Encode(codepoints), true
+	}
+	return "", false
+}
+
+// replaceUnknownEmojis converts every valid emoji sequence left in the kept
+// segments after the table lookups, written as an emoji or in any encoding
+// format, with encode. The formats in textOptInFormats are only scanned for
+// when escapes is set. Sequences encode can't convert are kept as they were
+// written.
+func replaceUnknownEmojis(segments []segment, escapes bool, encode func(codepoints []rune) (string, bool)) []segment {
+	replaceRuns := func(re *regexp.Regexp, parse func(run string) []escapedRune) func(text string) []segment {
+		return func(text string) []segment {
+			var b segmentBuilder
+			last := 0
+			for _, run := range re.FindAllStringIndex(text, -1) {
+				b.keep(text[last:run[0]])
+				b.add(replaceUnknownRunes(parse(text[run[0]:run[1]]), encode))
+				last = run[1]
+			}
+			b.keep(text[last:])
+			return b.segments
+		}
+	}
+
+	segments = applyPass(segments, replaceRuns(htmlEscapeRegex, parseHTMLEscapes))
+	segments = applyPass(segments, replaceRuns(unicodeEscapeRegex, parseUnicodeEscapes))
+	for _, codec := range textCodecs(escapes) {
+		if codec, ok := codec.(escapeCodec); ok {
+			segments = applyPass(segments, replaceRuns(codec.re, codec.parse))
+		}
+	}
+
+	// Emojis written as themselves, as whole grapheme clusters
+	return applyPass(segments, func(text string) []segment {
+		var b segmentBuilder
+		last := 0
+		for _, cluster := range findEmojiClusters(text) {
+			emoji := text[cluster[0]:cluster[1]]
+			if !isEmojiSequence(emoji) {
+				continue
+			}
+			if encoded, ok := encode([]rune(emoji)); ok {
+				b.keep(text[last:cluster[0]])
+				b.replace(encoded)
+				last = cluster[1]
+			}
+		}
+		b.keep(text[last:])
+		return b.segments
+	})
+}
+
+// replaceUnknownRunes converts the longest valid emoji sequence at each
+// position of a decoded escape run with encode.
+func replaceUnknownRunes(items []escapedRune, encode func(codepoints []rune) (string, bool)) []segment {
+	var b segmentBuilder
+	for i := 0; i < len(items); {
+		var runes []rune
+		for _, item := range items[i:] {
+			if item.r < 0 {
+				break
+			}
+			runes = append(runes, item.r)
+		}
+
+		s := string(runes)
+		if count := utf8.RuneCountInString(s[:emojiSequenceLength(s)]); count > 0 {
+			if encoded, ok := encode(runes[:count]); ok {
+				b.replace(encoded)
+				b.keep(items[i+count-1].sep)
+				i += count
+				continue
+			}
+		}
+
+		b.keep(items[i].source + items[i].sep)
+		i++
+	}
+	return b.segments
+}
+
+// parseHTMLEscapes decodes a run of &#xXXXX; references into code points.
+func parseHTMLEscapes(run string) []escapedRune {
+	return parseHexEscapes(run, htmlPartRegex)
+}
+
+// parseUnicodeEscapes decodes a run of \UXXXXXXXX and \uXXXX escapes into code points.
+func parseUnicodeEscapes(run string) []escapedRune {
+	return parseHexEscapes(run, unicodePartRegex)
+}
+
+// decodeRunes is like decodeEscapes, returning code points.
+func decodeRunes(s string, re *regexp.Regexp, parse func(run string) []escapedRune) ([]rune, bool) {
+	decoded, ok := decodeEscapes(s, re, parse)
+	if !ok {
+		return nil, false
+	}
+	return []rune(decoded), true
+}
@@ -0,0 +1,120 @@
+package gomoji
+
+import (
+	"context"
+	"testing"
+)
+
+func TestTransformWithFallback(t *testing.T) {
+	tests := []struct {
+		name         string
+		input        string
+		targetFormat Format
+		expected     string
+	}{
+		{"unknown emoji to HTML", "🫨", FormatHTML, "&#x1fae8;"},
+		{"unknown emoji to unicode", "🫨", FormatUnicode, `\U0001FAE8`},
+		{"unknown HTML to emoji", "&#x1fae8;", FormatEmoji, "🫨"},
+		{"unknown unicode to emoji", `\U0001FAE8`, FormatEmoji, "🫨"},
+		{"unknown modifier sequence", "👍🏽", FormatHTML, "&#x1f44d;&#x1f3fd;"},
+		{"unknown ZWJ sequence", "👨‍👩‍👧", FormatCodepoint, "U+1F468 U+200D U+1F469 U+200D U+1F467"},
+		{"unknown flag", "🇨🇭", FormatJSEscape, `\uD83C\uDDE8\uD83C\uDDED`},
+		{"unknown escape to emoji", `\u{1F9A9}`, FormatEmoji, "🦩"},
+		{"unknown python name to emoji", `\N{SHAKING FACE}`, FormatEmoji, "🫨"},
+		{"emoji newer than the tables to HTML", "\U0001FAE9", FormatHTML, "&#x1fae9;"},
+		{"HTML newer than the tables to emoji", "&#x1fae9;", FormatEmoji, "\U0001FAE9"},
+		{"escape newer than the tables to codepoint", `\U0001FAE9`, FormatCodepoint, "U+1FAE9"},
+		{"known emoji is unaffected", "😄", FormatShortcode, ":smile:"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := TransformWithOptions(tt.input, tt.targetFormat, Options{Fallback: true})
+			if err != nil {
+				t.Fatalf("TransformWithOptions(%s) returned error: %v", tt.input, err)
+			}
+			if result != tt.expected {
+				t.Errorf("TransformWithOptions(%s) = %s, expected %s", tt.input, result, tt.expected)
+			}
+		})
+	}
+
+	errorTests := []struct {
+		name         string
+		input        string
+		targetFormat Format
+		opts         Options
+	}{
+		{"fallback is opt-in", "🫨", FormatHTML, Options{}},
+		{"shortcode needs the table", "🫨", FormatShortcode, Options{Fallback: true}},
+		{"description needs the table", "&#x1fae8;", FormatDescription, Options{Fallback: true}},
+		{"text presentation is not an emoji", "©", FormatHTML, Options{Fallback: true}},
+		{"plain text is not an emoji", "abc", FormatHTML, Options{Fallback: true}},
+		{"lone regional indicator", "🇨", FormatHTML, Options{Fallback: true}},
+	}
+
+	for _, tt := range errorTests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := TransformWithOptions(tt.input, tt.targetFormat, tt.opts); err == nil {
+				t.Errorf("TransformWithOptions(%s) expected error, got nil", tt.input)
+			}
+		})
+	}
+}
+
+func TestTransformTextWithFallback(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name         string
+		input        string
+		targetFormat Format
+		opts         Options
+		expected     string
+	}{
+		{
+			name:         "unknown emojis to HTML",
+			input:        "New 🫨 and known 😄 © 2024",
+			targetFormat: FormatHTML,
+			opts:         Options{Fallback: true},
+			expected:     "New &#x1fae8; and known &#x1f604; © 2024",
+		},
+		{
+			name:         "unknown HTML back to emoji",
+			input:        "New &#x1fae8;&#x1f9a9; and known &#x1f604;",
+			targetFormat: FormatEmoji,
+			opts:         Options{Fallback: true},
+			expected:     "New 🫨🦩 and known 😄",
+		},
+		{
+			name:         "emoji newer than the tables",
+			input:        "Tired \U0001FAE9 face",
+			targetFormat: FormatHTML,
+			opts:         Options{Fallback: true},
+			expected:     "Tired &#x1fae9; face",
+		},
+		{
+			name:         "shortcodes keep unknown emojis",
+			input:        "New 🫨 and known 😄",
+			targetFormat: FormatShortcode,
+			opts:         Options{Fallback: true},
+			expected:     "New 🫨 and known :smile:",
+		},
+		{
+			name:         "fallback is opt-in",
+			input:        "New 🫨",
+			targetFormat: FormatHTML,
+			opts:         Options{},
+			expected:     "New 🫨",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := TransformTextWithOptions(ctx, tt.input, tt.targetFormat, tt.opts)
+			if result != tt.expected {
+				t.Errorf("TransformTextWithOptions(%q) = %q, expected %q", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
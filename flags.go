@@ -0,0 +1,380 @@
+package gomoji
+
+import (
+	"fmt"
+	"strings"
+)
+
+// flagRegion is a region with an emoji flag and its name in every supported
+// language.
+type flagRegion struct {
+	Code       string
+	English    string
+	Spanish    string
+	Portuguese string
+}
+
+// flagRegions lists every region with a regional-indicator emoji flag: the
+// ISO 3166-1 countries plus the other regions of the Unicode RGI set (EU, UN
+// and the exceptionally reserved AC, CP, DG, EA, IC, TA and XK). Names are
+// the CLDR region names.
+var flagRegions = []flagRegion{
+	{"AC", "Ascension Island", "Isla de la Ascensión", "Ilha de Ascensão"},
+	{"AD", "Andorra", "Andorra", "Andorra"},
+	{"AE", "United Arab Emirates", "Emiratos Árabes Unidos", "Emirados Árabes Unidos"},
+	{"AF", "Afghanistan", "Afganistán", "Afeganistão"},
+	{"AG", "Antigua & Barbuda", "Antigua y Barbuda", "Antígua e Barbuda"},
+	{"AI", "Anguilla", "Anguila", "Anguilla"},
+	{"AL", "Albania", "Albania", "Albânia"},
+	{"AM", "Armenia", "Armenia", "Armênia"},
+	{"AO", "Angola", "Angola", "Angola"},
+	{"AQ", "Antarctica", "Antártida", "Antártida"},
+	{"AR", "Argentina", "Argentina", "Argentina"},
+	{"AS", "American Samoa", "Samoa Americana", "Samoa Americana"},
+	{"AT", "Austria", "Austria", "Áustria"},
+	{"AU", "Australia", "Australia", "Austrália"},
+	{"AW", "Aruba", "Aruba", "Aruba"},
+	{"AX", "Åland Islands", "Islas Åland", "Ilhas Aland"},
+	{"AZ", "Azerbaijan", "Azerbaiyán", "Azerbaijão"},
+	{"BA", "Bosnia & Herzegovina", "Bosnia y Herzegovina", "Bósnia e Herzegovina"},
+	{"BB", "Barbados", "Barbados", "Barbados"},
+	{"BD", "Bangladesh", "Bangladés", "Bangladesh"},
+	{"BE", "Belgium", "Bélgica", "Bélgica"},
+	{"BF", "Burkina Faso", "Burkina Faso", "Burquina Faso"},
+	{"BG", "Bulgaria", "Bulgaria", "Bulgária"},
+	{"BH", "Bahrain", "Baréin", "Bahrein"},
+	{"BI", "Burundi", "Burundi", "Burundi"},
+	{"BJ", "Benin", "Benín", "Benin"},
+	{"BL", "St. Barthélemy", "San Bartolomé", "São Bartolomeu"},
+	{"BM", "Bermuda", "Bermudas", "Bermudas"},
+	{"BN", "Brunei", "Brunéi", "Brunei"},
+	{"BO", "Bolivia", "Bolivia", "Bolívia"},
+	{"BQ", "Caribbean Netherlands", "Caribe neerlandés", "Países Baixos Caribenhos"},
+	{"BR", "Brazil", "Brasil", "Brasil"},
+	{"BS", "Bahamas", "Bahamas", "Bahamas"},
+	{"BT", "Bhutan", "Bután", "Butão"},
+	{"BV", "Bouvet Island", "Isla Bouvet", "Ilha Bouvet"},
+	{"BW", "Botswana", "Botsuana", "Botsuana"},
+	{"BY", "Belarus", "Bielorrusia", "Bielorrússia"},
+	{"BZ", "Belize", "Belice", "Belize"},
+	{"CA", "Canada", "Canadá", "Canadá"},
+	{"CC", "Cocos (Keeling) Islands", "Islas Cocos", "Ilhas Cocos (Keeling)"},
+	{"CD", "Congo - Kinshasa", "República Democrática del Congo", "Congo - Kinshasa"},
+	{"CF", "Central African Republic", "República Centroafricana", "República Centro-Africana"},
+	{"CG", "Congo - Brazzaville", "República del Congo", "Congo - Brazzaville"},
+	{"CH", "Switzerland", "Suiza", "Suíça"},
+	{"CI", "Côte d’Ivoire", "Côte d’Ivoire", "Costa do Marfim"},
+	{"CK", "Cook Islands", "Islas Cook", "Ilhas Cook"},
+	{"CL", "Chile", "Chile", "Chile"},
+	{"CM", "Cameroon", "Camerún", "Camarões"},
+	{"CN", "China", "China", "China"},
+	{"CO", "Colombia", "Colombia", "Colômbia"},
+	{"CP", "Clipperton Island", "Isla Clipperton", "Ilha de Clipperton"},
+	{"CR", "Costa Rica", "Costa Rica", "Costa Rica"},
+	{"CU", "Cuba", "Cuba", "Cuba"},
+	{"CV", "Cabo Verde", "Cabo Verde", "Cabo Verde"},
+	{"CW", "Curaçao", "Curazao", "Curaçao"},
+	{"CX", "Christmas Island", "Isla de Navidad", "Ilha Christmas"},
+	{"CY", "Cyprus", "Chipre", "Chipre"},
+	{"CZ", "Czechia", "Chequia", "Tchéquia"},
+	{"DE", "Germany", "Alemania", "Alemanha"},
+	{"DG", "Diego Garcia", "Diego García", "Diego Garcia"},
+	{"DJ", "Djibouti", "Yibuti", "Djibuti"},
+	{"DK", "Denmark", "Dinamarca", "Dinamarca"},
+	{"DM", "Dominica", "Dominica", "Dominica"},
+	{"DO", "Dominican Republic", "República Dominicana", "República Dominicana"},
+	{"DZ", "Algeria", "Argelia", "Argélia"},
+	{"EA", "Ceuta & Melilla", "Ceuta y Melilla", "Ceuta e Melilha"},
+	{"EC", "Ecuador", "Ecuador", "Equador"},
+	{"EE", "Estonia", "Estonia", "Estônia"},
+	{"EG", "Egypt", "Egipto", "Egito"},
+	{"EH", "Western Sahara", "Sáhara Occidental", "Saara Ocidental"},
+	{"ER", "Eritrea", "Eritrea", "Eritreia"},
+	{"ES", "Spain", "España", "Espanha"},
+	{"ET", "Ethiopia", "Etiopía", "Etiópia"},
+	{"EU", "European Union", "Unión Europea", "União Europeia"},
+	{"FI", "Finland", "Finlandia", "Finlândia"},
+	{"FJ", "Fiji", "Fiyi", "Fiji"},
+	{"FK", "Falkland Islands", "Islas Malvinas", "Ilhas Malvinas"},
+	{"FM", "Micronesia", "Micronesia", "Micronésia"},
+	{"FO", "Faroe Islands", "Islas Feroe", "Ilhas Faroe"},
+	{"FR", "France", "Francia", "França"},
+	{"GA", "Gabon", "Gabón", "Gabão"},
+	{"GB", "United Kingdom", "Reino Unido", "Reino Unido"},
+	{"GD", "Grenada", "Granada", "Granada"},
+	{"GE", "Georgia", "Georgia", "Geórgia"},
+	{"GF", "French Guiana", "Guayana Francesa", "Guiana Francesa"},
+	{"GG", "Guernsey", "Guernsey", "Guernsey"},
+	{"GH", "Ghana", "Ghana", "Gana"},
+	{"GI", "Gibraltar", "Gibraltar", "Gibraltar"},
+	{"GL", "Greenland", "Groenlandia", "Groenlândia"},
+	{"GM", "Gambia", "Gambia", "Gâmbia"},
+	{"GN", "Guinea", "Guinea", "Guiné"},
+	{"GP", "Guadeloupe", "Guadalupe", "Guadalupe"},
+	{"GQ", "Equatorial Guinea", "Guinea Ecuatorial", "Guiné Equatorial"},
+	{"GR", "Greece", "Grecia", "Grécia"},
+	{"GS", "South Georgia & South Sandwich Islands", "Islas Georgia del Sur y Sandwich del Sur", "Ilhas Geórgia do Sul e Sandwich do Sul"},
+	{"GT", "Guatemala", "Guatemala", "Guatemala"},
+	{"GU", "Guam", "Guam", "Guam"},
+	{"GW", "Guinea-Bissau", "Guinea-Bisáu", "Guiné-Bissau"},
+	{"GY", "Guyana", "Guyana", "Guiana"},
+	{"HK", "Hong Kong SAR China", "RAE de Hong Kong (China)", "Hong Kong, RAE da China"},
+	{"HM", "Heard & McDonald Islands", "Islas Heard y McDonald", "Ilhas Heard e McDonald"},
+	{"HN", "Honduras", "Honduras", "Honduras"},
+	{"HR", "Croatia", "Croacia", "Croácia"},
+	{"HT", "Haiti", "Haití", "Haiti"},
+	{"HU", "Hungary", "Hungría", "Hungria"},
+	{"IC", "Canary Islands", "Canarias", "Ilhas Canárias"},
+	{"ID", "Indonesia", "Indonesia", "Indonésia"},
+	{"IE", "Ireland", "Irlanda", "Irlanda"},
+	{"IL", "Israel", "Israel", "Israel"},
+	{"IM", "Isle of Man", "Isla de Man", "Ilha de Man"},
+	{"IN", "India", "India", "Índia"},
+	{"IO", "British Indian Ocean Territory", "Territorio Británico del Océano Índico", "Território Britânico do Oceano Índico"},
+	{"IQ", "Iraq", "Irak", "Iraque"},
+	{"IR", "Iran", "Irán", "Irã"},
+	{"IS", "Iceland", "Islandia", "Islândia"},
+	{"IT", "Italy", "Italia", "Itália"},
+	{"JE", "Jersey", "Jersey", "Jersey"},
+	{"JM", "Jamaica", "Jamaica", "Jamaica"},
+	{"JO", "Jordan", "Jordania", "Jordânia"},
+	{"JP", "Japan", "Japón", "Japão"},
+	{"KE", "Kenya", "Kenia", "Quênia"},
+	{"KG", "Kyrgyzstan", "Kirguistán", "Quirguistão"},
+	{"KH", "Cambodia", "Camboya", "Camboja"},
+	{"KI", "Kiribati", "Kiribati", "Quiribati"},
+	{"KM", "Comoros", "Comoras", "Comores"},
+	{"KN", "St. Kitts & Nevis", "San Cristóbal y Nieves", "São Cristóvão e Névis"},
+	{"KP", "North Korea", "Corea del Norte", "Coreia do Norte"},
+	{"KR", "South Korea", "Corea del Sur", "Coreia do Sul"},
+	{"KW", "Kuwait", "Kuwait", "Kuwait"},
+	{"KY", "Cayman Islands", "Islas Caimán", "Ilhas Cayman"},
+	{"KZ", "Kazakhstan", "Kazajistán", "Cazaquistão"},
+	{"LA", "Laos", "Laos", "Laos"},
+	{"LB", "Lebanon", "Líbano", "Líbano"},
+	{"LC", "St. Lucia", "Santa Lucía", "Santa Lúcia"},
+	{"LI", "Liechtenstein", "Liechtenstein", "Liechtenstein"},
+	{"LK", "Sri Lanka", "Sri Lanka", "Sri Lanka"},
+	{"LR", "Liberia", "Liberia", "Libéria"},
+	{"LS", "Lesotho", "Lesoto", "Lesoto"},
+	{"LT", "Lithuania", "Lituania", "Lituânia"},
+	{"LU", "Luxembourg", "Luxemburgo", "Luxemburgo"},
+	{"LV", "Latvia", "Letonia", "Letônia"},
+	{"LY", "Libya", "Libia", "Líbia"},
+	{"MA", "Morocco", "Marruecos", "Marrocos"},
+	{"MC", "Monaco", "Mónaco", "Mônaco"},
+	{"MD", "Moldova", "Moldavia", "Moldávia"},
+	{"ME", "Montenegro", "Montenegro", "Montenegro"},
+	{"MF", "St. Martin", "San Martín", "São Martinho"},
+	{"MG", "Madagascar", "Madagascar", "Madagascar"},
+	{"MH", "Marshall Islands", "Islas Marshall", "Ilhas Marshall"},
+	{"MK", "North Macedonia", "Macedonia del Norte", "Macedônia do Norte"},
+	{"ML", "Mali", "Mali", "Mali"},
+	{"MM", "Myanmar (Burma)", "Myanmar (Birmania)", "Mianmar (Birmânia)"},
+	{"MN", "Mongolia", "Mongolia", "Mongólia"},
+	{"MO", "Macau SAR China", "RAE de Macao (China)", "Macau, RAE da China"},
+	{"MP", "Northern Mariana Islands", "Islas Marianas del Norte", "Ilhas Marianas do Norte"},
+	{"MQ", "Martinique", "Martinica", "Martinica"},
+	{"MR", "Mauritania", "Mauritania", "Mauritânia"},
+	{"MS", "Montserrat", "Montserrat", "Montserrat"},
+	{"MT", "Malta", "Malta", "Malta"},
+	{"MU", "Mauritius", "Mauricio", "Maurício"},
+	{"MV", "Maldives", "Maldivas", "Maldivas"},
+	{"MW", "Malawi", "Malaui", "Malaui"},
+	{"MX", "Mexico", "México", "México"},
+	{"MY", "Malaysia", "Malasia", "Malásia"},
+	{"MZ", "Mozambique", "Mozambique", "Moçambique"},
+	{"NA", "Namibia", "Namibia", "Namíbia"},
+	{"NC", "New Caledonia", "Nueva Caledonia", "Nova Caledônia"},
+	{"NE", "Niger", "Níger", "Níger"},
+	{"NF", "Norfolk Island", "Isla Norfolk", "Ilha Norfolk"},
+	{"NG", "Nigeria", "Nigeria", "Nigéria"},
+	{"NI", "Nicaragua", "Nicaragua", "Nicarágua"},
+	{"NL", "Netherlands", "Países Bajos", "Holanda"},
+	{"NO", "Norway", "Noruega", "Noruega"},
+	{"NP", "Nepal", "Nepal", "Nepal"},
+	{"NR", "Nauru", "Nauru", "Nauru"},
+	{"NU", "Niue", "Niue", "Niue"},
+	{"NZ", "New Zealand", "Nueva Zelanda", "Nova Zelândia"},
+	{"OM", "Oman", "Omán", "Omã"},
+	{"PA", "Panama", "Panamá", "Panamá"},
+	{"PE", "Peru", "Perú", "Peru"},
+	{"PF", "French Polynesia", "Polinesia Francesa", "Polinésia Francesa"},
+	{"PG", "Papua New Guinea", "Papúa Nueva Guinea", "Papua-Nova Guiné"},
+	{"PH", "Philippines", "Filipinas", "Filipinas"},
+	{"PK", "Pakistan", "Pakistán", "Paquistão"},
+	{"PL", "Poland", "Polonia", "Polônia"},
+	{"PM", "St. Pierre & Miquelon", "San Pedro y Miquelón", "São Pedro e Miquelão"},
+	{"PN", "Pitcairn Islands", "Islas Pitcairn", "Ilhas Pitcairn"},
+	{"PR", "Puerto Rico", "Puerto Rico", "Porto Rico"},
+	{"PS", "Palestinian Territories", "Territorios Palestinos", "Territórios palestinos"},
+	{"PT", "Portugal", "Portugal", "Portugal"},
+	{"PW", "Palau", "Palaos", "Palau"},
+	{"PY", "Paraguay", "Paraguay", "Paraguai"},
+	{"QA", "Qatar", "Catar", "Catar"},
+	{"RE", "Réunion", "Reunión", "Reunião"},
+	{"RO", "Romania", "Rumanía", "Romênia"},
+	{"RS", "Serbia", "Serbia", "Sérvia"},
+	{"RU", "Russia", "Rusia", "Rússia"},
+	{"RW", "Rwanda", "Ruanda", "Ruanda"},
+	{"SA", "Saudi Arabia", "Arabia Saudí", "Arábia Saudita"},
+	{"SB", "Solomon Islands", "Islas Salomón", "Ilhas Salomão"},
+	{"SC", "Seychelles", "Seychelles", "Seicheles"},
+	{"SD", "Sudan", "Sudán", "Sudão"},
+	{"SE", "Sweden", "Suecia", "Suécia"},
+	{"SG", "Singapore", "Singapur", "Singapura"},
+	{"SH", "St. Helena", "Santa Elena", "Santa Helena"},
+	{"SI", "Slovenia", "Eslovenia", "Eslovênia"},
+	{"SJ", "Svalbard & Jan Mayen", "Svalbard y Jan Mayen", "Svalbard e Jan Mayen"},
+	{"SK", "Slovakia", "Eslovaquia", "Eslováquia"},
+	{"SL", "Sierra Leone", "Sierra Leona", "Serra Leoa"},
+	{"SM", "San Marino", "San Marino", "San Marino"},
+	{"SN", "Senegal", "Senegal", "Senegal"},
+	{"SO", "Somalia", "Somalia", "Somália"},
+	{"SR", "Suriname", "Surinam", "Suriname"},
+	{"SS", "South Sudan", "Sudán del Sur", "Sudão do Sul"},
+	{"ST", "São Tomé & Príncipe", "Santo Tomé y Príncipe", "São Tomé e Príncipe"},
+	{"SV", "El Salvador", "El Salvador", "El Salvador"},
+	{"SX", "Sint Maarten", "Sint Maarten", "Sint Maarten"},
+	{"SY", "Syria", "Siria", "Síria"},
+	{"SZ", "Eswatini", "Esuatini", "Essuatíni"},
+	{"TA", "Tristan da Cunha", "Tristán de Acuña", "Tristão da Cunha"},
+	{"TC", "Turks & Caicos Islands", "Islas Turcas y Caicos", "Ilhas Turks e Caicos"},
+	{"TD", "Chad", "Chad", "Chade"},
+	{"TF", "French Southern Territories", "Territorios Australes Franceses", "Territórios Franceses do Sul"},
+	{"TG", "Togo", "Togo", "Togo"},
+	{"TH", "Thailand", "Tailandia", "Tailândia"},
+	{"TJ", "Tajikistan", "Tayikistán", "Tadjiquistão"},
+	{"TK", "Tokelau", "Tokelau", "Tokelau"},
+	{"TL", "Timor-Leste", "Timor-Leste", "Timor-Leste"},
+	{"TM", "Turkmenistan", "Turkmenistán", "Turcomenistão"},
+	{"TN", "Tunisia", "Túnez", "Tunísia"},
+	{"TO", "Tonga", "Tonga", "Tonga"},
+	{"TR", "Türkiye", "Turquía", "Turquia"},
+	{"TT", "Trinidad & Tobago", "Trinidad y Tobago", "Trinidad e Tobago"},
+	{"TV", "Tuvalu", "Tuvalu", "Tuvalu"},
+	{"TW", "Taiwan", "Taiwán", "Taiwan"},
+	{"TZ", "Tanzania", "Tanzania", "Tanzânia"},
+	{"UA", "Ukraine", "Ucrania", "Ucrânia"},
+	{"UG", "Uganda", "Uganda", "Uganda"},
+	{"UM", "U.S. Outlying Islands", "Islas menores alejadas de EE. UU.", "Ilhas Menores Distantes dos EUA"},
+	{"UN", "United Nations", "Naciones Unidas", "Nações Unidas"},
+	{"US", "United States", "Estados Unidos", "Estados Unidos"},
+	{"UY", "Uruguay", "Uruguay", "Uruguai"},
+	{"UZ", "Uzbekistan", "Uzbekistán", "Uzbequistão"},
+	{"VA", "Vatican City", "Ciudad del Vaticano", "Cidade do Vaticano"},
+	{"VC", "St. Vincent & Grenadines", "San Vicente y las Granadinas", "São Vicente e Granadinas"},
+	{"VE", "Venezuela", "Venezuela", "Venezuela"},
+	{"VG", "British Virgin Islands", "Islas Vírgenes Británicas", "Ilhas Virgens Britânicas"},
+	{"VI", "U.S. Virgin Islands", "Islas Vírgenes de EE. UU.", "Ilhas Virgens Americanas"},
+	{"VN", "Vietnam", "Vietnam", "Vietnã"},
+	{"VU", "Vanuatu", "Vanuatu", "Vanuatu"},
+	{"WF", "Wallis & Futuna", "Wallis y Futuna", "Wallis e Futuna"},
+	{"WS", "Samoa", "Samoa", "Samoa"},
+	{"XK", "Kosovo", "Kosovo", "Kosovo"},
+	{"YE", "Yemen", "Yemen", "Iêmen"},
+	{"YT", "Mayotte", "Mayotte", "Mayotte"},
+	{"ZA", "South Africa", "Sudáfrica", "África do Sul"},
+	{"ZM", "Zambia", "Zambia", "Zâmbia"},
+	{"ZW", "Zimbabwe", "Zimbabue", "Zimbábue"},
+}
+
+// flagRegionCodes holds the codes of flagRegions for validation
+var flagRegionCodes map[string]bool
+
+// FlagFromCountryCode returns the flag emoji of an ISO 3166-1 alpha-2
+// country code. Codes are case-insensitive.
+//
+// Example:
+//
+//	flag, err := FlagFromCountryCode("PE")
+//	// flag: "🇵🇪"
+func FlagFromCountryCode(code string) (string, error) {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	if !flagRegionCodes[code] {
+		return "", fmt.Errorf("no flag for country code: %q", code)
+	}
+	return regionFlag(code), nil
+}
+
+// CountryCodeFromFlag returns the uppercase ISO 3166-1 alpha-2 country code
+// of a flag. The flag can be in any supported format.
+//
+// Example:
+//
+//	code, err := CountryCodeFromFlag("🇵🇪")
+//	// code: "PE"
+func CountryCodeFromFlag(input string) (string, error) {
+	emoji := strings.TrimSpace(input)
+	if name := findEmojiName(input); name != "" {
+		emoji = emojiMappings[name].Emoji
+	}
+
+	runes := []rune(emoji)
+	if len(runes) != 2 || !isRegionalIndicator(runes[0]) || !isRegionalIndicator(runes[1]) {
+		return "", fmt.Errorf("not a country flag: %q", input)
+	}
+	code := string([]rune{'A' + runes[0] - 0x1F1E6, 'A' + runes[1] - 0x1F1E6})
+	if !flagRegionCodes[code] {
+		return "", fmt.Errorf("not a country flag: %q", input)
+	}
+	return code, nil
+}
+
+// regionFlag returns the pair of regional indicator symbols for an uppercase
+// two-letter region code.
+func regionFlag(code string) string {
+	return string([]rune{0x1F1E6 + rune(code[0]-'A'), 0x1F1E6 + rune(code[1]-'A')})
+}
+
+// flagVersions holds the Emoji version of the region flags that were not
+// added in Emoji 2.0: the ten original flags and the United Nations flag.
+var flagVersions = map[string]float64{
+	"CN": 0.6, "DE": 0.6, "ES": 0.6, "FR": 0.6, "GB": 0.6,
+	"IT": 0.6, "JP": 0.6, "KR": 0.6, "RU": 0.6, "US": 0.6,
+	"UN": 4.0,
+}
+
+// regionFlagVersion returns the Emoji version that introduced the flag of
+// the region with the given uppercase code.
+func regionFlagVersion(code string) float64 {
+	if version, exists := flagVersions[code]; exists {
+		return version
+	}
+	return 2.0
+}
+
+// addFlagMappings adds a mapping and annotations, named flag_xx, for every
+// region flag missing from the emoji table.
+func addFlagMappings() {
+	flagRegionCodes = make(map[string]bool, len(flagRegions))
+	for _, region := range flagRegions {
+		flagRegionCodes[region.Code] = true
+
+		lower := strings.ToLower(region.Code)
+		name := "flag_" + lower
+		if _, exists := emojiMappings[name]; exists {
+			continue
+		}
+
+		emojiMappings[name] = Mapping{
+			Emoji:        regionFlag(region.Code),
+			Shortcode:    ":" + name + ":",
+			EmojiVersion: regionFlagVersion(region.Code),
+		}
+		emojiAnnotations[name] = annotation{
+			Name:     "flag: " + region.English,
+			Keywords: []string{"flag", region.English},
+		}
+		emojiAnnotationsES[name] = annotation{
+			Name:      "bandera: " + region.Spanish,
+			Shortcode: ":bandera_" + lower + ":",
+			Keywords:  []string{"bandera", region.Spanish},
+		}
+		emojiAnnotationsPT[name] = annotation{
+			Name:      "bandeira: " + region.Portuguese,
+			Shortcode: ":bandeira_" + lower + ":",
+			Keywords:  []string{"bandeira", region.Portuguese},
+		}
+	}
+}
@@ -0,0 +1,164 @@
+package gomoji
+
+import (
+	"context"
+	"testing"
+
+	"golang.org/x/text/language"
+)
+
+func TestFlagFromCountryCode(t *testing.T) {
+	tests := []struct {
+		code     string
+		expected string
+	}{
+		{"PE", "🇵🇪"},
+		{"pe", "🇵🇪"},
+		{" jp ", "🇯🇵"},
+		{"XK", "🇽🇰"},
+		{"EU", "🇪🇺"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.code, func(t *testing.T) {
+			flag, err := FlagFromCountryCode(tt.code)
+			if err != nil {
+				t.Fatalf("FlagFromCountryCode(%q) returned error: %v", tt.code, err)
+			}
+			if flag != tt.expected {
+				t.Errorf("FlagFromCountryCode(%q) = %s, expected %s", tt.code, flag, tt.expected)
+			}
+		})
+	}
+
+	for _, code := range []string{"", "XX", "EZ", "PER", "P"} {
+		if _, err := FlagFromCountryCode(code); err == nil {
+			t.Errorf("FlagFromCountryCode(%q) expected error, got nil", code)
+		}
+	}
+}
+
+func TestCountryCodeFromFlag(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{"emoji", "🇵🇪", "PE"},
+		{"hand-picked flag", "🇨🇴", "CO"},
+		{"shortcode", ":flag_nz:", "NZ"},
+		{"HTML", "&#x1f1e8;&#x1f1ed;", "CH"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, err := CountryCodeFromFlag(tt.input)
+			if err != nil {
+				t.Fatalf("CountryCodeFromFlag(%s) returned error: %v", tt.input, err)
+			}
+			if code != tt.expected {
+				t.Errorf("CountryCodeFromFlag(%s) = %s, expected %s", tt.input, code, tt.expected)
+			}
+		})
+	}
+
+	for _, input := range []string{"😄", "🇪🇿", "🇵", "PE"} {
+		if _, err := CountryCodeFromFlag(input); err == nil {
+			t.Errorf("CountryCodeFromFlag(%q) expected error, got nil", input)
+		}
+	}
+}
+
+func TestFlagMappings(t *testing.T) {
+	ctx := context.Background()
+
+	for _, region := range flagRegions {
+		flag, err := FlagFromCountryCode(region.Code)
+		if err != nil {
+			t.Fatalf("FlagFromCountryCode(%s) returned error: %v", region.Code, err)
+		}
+		code, err := CountryCodeFromFlag(flag)
+		if err != nil || code != region.Code {
+			t.Errorf("CountryCodeFromFlag(%s) = %s, %v, expected %s", flag, code, err, region.Code)
+		}
+		if !IsSupported(flag) {
+			t.Errorf("flag of %s is not supported", region.Code)
+		}
+	}
+
+	result, err := Transform(":flag_pe:", FormatHTML)
+	if err != nil {
+		t.Fatalf("Transform(:flag_pe:) returned error: %v", err)
+	}
+	if result != "&#x1f1f5;&#x1f1ea;" {
+		t.Errorf("Transform(:flag_pe:) = %s, expected &#x1f1f5;&#x1f1ea;", result)
+	}
+
+	text := TransformText(ctx, "Trip: 🇵🇪🇨🇱 then 🇯🇵", FormatShortcode)
+	if text != "Trip: :flag_pe::flag_cl: then :flag_jp:" {
+		t.Errorf("TransformText = %q, expected %q", text, "Trip: :flag_pe::flag_cl: then :flag_jp:")
+	}
+
+	info, err := GetEmojiInfoLocalized("🇵🇪", language.Spanish)
+	if err != nil {
+		t.Fatalf("GetEmojiInfoLocalized returned error: %v", err)
+	}
+	if info.Shortcode != ":bandera_pe:" || info.Description != "bandera: Perú" {
+		t.Errorf("GetEmojiInfoLocalized(🇵🇪, es) = %s %q, expected :bandera_pe: %q", info.Shortcode, info.Description, "bandera: Perú")
+	}
+}
+
+func TestTagSequenceFlags(t *testing.T) {
+	const england = "\U0001F3F4\U000E0067\U000E0062\U000E0065\U000E006E\U000E0067\U000E007F"
+	const englandHTML = "&#x1f3f4;&#xe0067;&#xe0062;&#xe0065;&#xe006e;&#xe0067;&#xe007f;"
+
+	tests := []struct {
+		name         string
+		input        string
+		targetFormat Format
+		expected     string
+	}{
+		{"shortcode to emoji", ":flag_gb_eng:", FormatEmoji, england},
+		{"emoji to shortcode", england, FormatShortcode, ":flag_gb_eng:"},
+		{"alias", ":scotland:", FormatShortcode, ":flag_gb_sct:"},
+		{"emoji to HTML", england, FormatHTML, englandHTML},
+		{"HTML to shortcode", englandHTML, FormatShortcode, ":flag_gb_eng:"},
+		{"emoji to unicode", ":flag_gb_wls:", FormatUnicode, `\U0001F3F4\U000E0067\U000E0062\U000E0077\U000E006C\U000E0073\U000E007F`},
+		{"emoji to JS escape", ":flag_gb_eng:", FormatJSEscape, `\uD83C\uDFF4\uDB40\uDC67\uDB40\uDC62\uDB40\uDC65\uDB40\uDC6E\uDB40\uDC67\uDB40\uDC7F`},
+		{"description", ":flag_gb_sct:", FormatDescription, "flag: Scotland"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Transform(tt.input, tt.targetFormat)
+			if err != nil {
+				t.Fatalf("Transform(%s) returned error: %v", tt.input, err)
+			}
+			if result != tt.expected {
+				t.Errorf("Transform(%s) = %s, expected %s", tt.input, result, tt.expected)
+			}
+		})
+	}
+
+	ctx := context.Background()
+	text := TransformText(ctx, "Go "+england+"🇬🇧!", FormatShortcode)
+	if text != "Go :flag_gb_eng::flag_gb:!" {
+		t.Errorf("TransformText = %q, expected %q", text, "Go :flag_gb_eng::flag_gb:!")
+	}
+
+	text = TransformText(ctx, "Go "+englandHTML+"!", FormatEmoji)
+	if text != "Go "+england+"!" {
+		t.Errorf("TransformText from HTML = %q, expected %q", text, "Go "+england+"!")
+	}
+
+	// An unknown tag sequence is kept whole
+	const texas = "\U0001F3F4\U000E0075\U000E0073\U000E0074\U000E0078\U000E007F"
+	text = TransformText(ctx, texas+" "+england, FormatShortcode)
+	if text != texas+" :flag_gb_eng:" {
+		t.Errorf("TransformText with unknown tag sequence = %q, expected %q", text, texas+" :flag_gb_eng:")
+	}
+
+	if _, err := CountryCodeFromFlag(england); err == nil {
+		t.Error("CountryCodeFromFlag(England) expected error, got nil")
+	}
+}
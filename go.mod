@@ -5,6 +5,7 @@ go 1.24.2
 require (
 	github.com/Santiago-Balcero/gobserve v1.0.0
 	go.uber.org/zap v1.27.0
+	golang.org/x/text v0.27.0
 )
 
 require (
@@ -39,7 +40,6 @@ require (
 	golang.org/x/net v0.42.0 // indirect
 	golang.org/x/sync v0.16.0 // indirect
 	golang.org/x/sys v0.35.0 // indirect
-	golang.org/x/text v0.27.0 // indirect
 	golang.org/x/tools v0.34.0 // indirect
 	google.golang.org/protobuf v1.36.9 // indirect
 )
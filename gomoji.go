@@ -39,6 +39,7 @@ import (
 
 	"github.com/Santiago-Balcero/gobserve"
 	"go.uber.org/zap"
+	"golang.org/x/text/language"
 )
 
 // Format represents the different emoji format types.
@@ -53,18 +54,85 @@ const (
 	FormatHTML Format = "html"
 	// FormatUnicode represents the unicode escape sequence format (\U0001F399\uFE0F).
 	FormatUnicode Format = "unicode"
+	// FormatDescription represents the CLDR short name of the emoji (studio microphone).
+	FormatDescription Format = "description"
+	// FormatEmoticon represents the ASCII emoticon (:-D), falling back to the
+	// shortcode for emojis without one.
+	FormatEmoticon Format = "emoticon"
+	// FormatJSEscape represents the JavaScript/JSON escape sequence format,
+	// with UTF-16 surrogate pairs (\uD83C\uDF99\uFE0F).
+	FormatJSEscape Format = "jsescape"
+	// FormatCodepoint represents the Unicode code-point notation (U+1F399 U+FE0F).
+	// Use TransformCodepoint or Options.CodepointSeparator for another separator.
+	FormatCodepoint Format = "codepoint"
+	// FormatRust represents the Rust escape sequence format (\u{1F399}\u{FE0F}).
+	FormatRust Format = "rust"
+	// FormatCSS represents the CSS escape sequence format (\1F399 \FE0F ),
+	// with each escape terminated by a space.
+	FormatCSS Format = "css"
+	// FormatPython represents the Python named escape format, using Unicode
+	// character names (\N{STUDIO MICROPHONE}\N{VARIATION SELECTOR-16}).
+	FormatPython Format = "python"
+	// FormatJava represents the Java and C# escape sequence format, with
+	// UTF-16 surrogate pairs (\uD83C\uDF99\uFE0F). It matches FormatJSEscape.
+	FormatJava Format = "java"
 )
 
+// shortcodeRegex matches shortcode candidates such as :smile:.
+var shortcodeRegex = regexp.MustCompile(`:[a-zA-Z0-9_+\-]+:`)
+
+// Options configures how TransformWithOptions and TransformTextWithOptions
+// recognize emojis.
+type Options struct {
+	// Emoticons enables recognition of ASCII emoticons such as :) or <3.
+	// Emoticons inside URLs are never converted.
+	Emoticons bool
+	// Escapes enables recognition of Rust (\u{1F604}), CSS (\1F604) and
+	// Python (\N{GRINNING FACE}) escapes in text. Transform always accepts
+	// them, but in text a backslash followed by hex digits, as in a Windows
+	// path, is more often not an emoji.
+	Escapes bool
+	// CodepointSeparator joins the code points of multi-code-point emojis
+	// when transforming to FormatCodepoint. It defaults to a single space.
+	CodepointSeparator string
+	// Fallback converts valid emoji sequences missing from the table, such
+	// as emojis from newer Unicode versions, between the encoding formats:
+	// emoji, HTML, unicode and the escape formats. Shortcodes, descriptions
+	// and emoticons still need the table.
+	Fallback bool
+	// Locales lists the languages whose localized shortcodes are recognized,
+	// such as :sonrisa: for Spanish. English shortcodes take precedence and
+	// output shortcodes stay in English. TransformWithOptions fails on an
+	// unsupported language, while TransformTextWithOptions ignores it.
+	Locales []language.Tag
+}
+
 // Mapping represents all possible formats for a single emoji.
 type Mapping struct {
 	// Emoji is the actual unicode emoji character.
 	Emoji string
 	// Shortcode is the textual shortcode representation.
 	Shortcode string
-	// HTML is the HTML entity representation.
+	// HTML is the HTML entity representation, derived from the code points.
 	HTML string
-	// Unicode is the unicode escape sequence representation.
+	// Unicode is the unicode escape sequence representation, derived from the
+	// code points.
 	Unicode string
+	// Description is the CLDR short name of the emoji (grinning face with smiling eyes).
+	Description string
+	// Keywords are the CLDR annotation keywords describing the emoji's meaning.
+	Keywords []string
+	// Qualification is the qualification status of Emoji, which is always
+	// fully qualified. GetEmojiInfo reports the qualification of its input.
+	Qualification Qualification
+	// EmojiVersion is the Emoji version that introduced the emoji (13.0).
+	// Emoji versions match Unicode versions from Emoji 11.0 on.
+	EmojiVersion float64
+}
+
+// Codepoints returns the Unicode code points of the emoji, in order.
+func (m Mapping) Codepoints() []rune {
+	return []rune(m.Emoji)
 }
 
 // Transform converts between different emoji formats.
@@ -75,23 +143,34 @@ type Mapping struct {
 //   - A shortcode (e.g., ":smile:" or "smile")
 //   - An HTML entity (e.g., "&#x1f604;")
 //   - A unicode escape sequence (e.g., "\\U0001F604")
+//   - An ASCII emoticon (e.g., ":)")
+//   - A JavaScript/JSON, Java or C# escape sequence (e.g., "\\uD83D\\uDE04")
+//   - Code-point notation (e.g., "U+1F604" or "U+1F399 U+FE0F")
+//   - A Rust escape sequence (e.g., "\\u{1F604}")
+//   - A CSS escape sequence (e.g., "\\1F604 ")
+//   - A Python named escape (e.g., "\\N{SMILING FACE WITH OPEN MOUTH AND SMILING EYES}")
 //
 // The targetFormat specifies the desired output format.
 //
 // Returns an error if the input emoji is not supported or the target format is invalid.
+// When the input is not supported the error is a *NotFoundError, which carries
+// "did you mean" suggestions for misspelled names and shortcodes.
 func Transform(input string, targetFormat Format) (string, error) {
+	return transformLocalized(input, targetFormat, nil)
+}
+
+// transformLocalized is Transform, also recognizing the shortcodes of locales.
+func transformLocalized(input string, targetFormat Format, locales []language.Tag) (string, error) {
 	// Validate target format
-	switch targetFormat {
-	case FormatEmoji, FormatShortcode, FormatHTML, FormatUnicode:
-		// Valid format
-	default:
-		return "", fmt.Errorf("invalid target format: %s. Valid formats: emoji, shortcode, html, unicode", targetFormat)
+	codec, isCodec := lookupCodec(targetFormat)
+	if !isCodec && !isBuiltinFormat(targetFormat) {
+		return "", fmt.Errorf("invalid target format: %s. Valid formats: %s", targetFormat, validFormats())
 	}
 
 	// First, try to identify what format the input is and find the emoji name
-	emojiName := findEmojiName(input)
+	emojiName := resolveEmojiName(input, locales)
 	if emojiName == "" {
-		return "", fmt.Errorf("emoji not found or not supported: %s", input)
+		return "", newNotFoundError(input)
 	}
 
 	// Get the mapping for this emoji
@@ -100,6 +179,11 @@ func Transform(input string, targetFormat Format) (string, error) {
 		return "", fmt.Errorf("emoji mapping not found: %s", emojiName)
 	}
 
+	// Formats with a codec encode the emoji's code points
+	if isCodec {
+		return codec.Encode(mapping.Codepoints()), nil
+	}
+
 	// Return the requested format
 	switch targetFormat {
 	case FormatEmoji:
@@ -110,6 +194,13 @@ func Transform(input string, targetFormat Format) (string, error) {
 		return mapping.HTML, nil
 	case FormatUnicode:
 		return mapping.Unicode, nil
+	case FormatDescription:
+		return mapping.Description, nil
+	case FormatEmoticon:
+		if emoticons := emojiEmoticons[emojiName]; len(emoticons) > 0 {
+			return emoticons[0], nil
+		}
+		return mapping.Shortcode, nil
 	default:
 		return "", fmt.Errorf("unexpected format: %s", targetFormat)
 	}
@@ -126,58 +217,167 @@ func Transform(input string, targetFormat Format) (string, error) {
 //	result, err := TransformText(text, FormatShortcode)
 //	// result: "Hello :smile: :wink: :thumbs_up: world!"
 func TransformText(ctx context.Context, text string, targetFormat Format) string {
-	result := text
-
-	// Transform actual emojis
-	for emoji, name := range emojiToName {
-		if strings.Contains(result, emoji) {
-			transformed, err := Transform(name, targetFormat)
-			if err != nil {
-				gobserve.AddLogFields(
-					ctx,
-					zap.Error(fmt.Errorf("transformation for emoji %q with name %q failed: %w", emoji, name, err)),
-				)
-				continue // Skip if transformation fails
-			}
-			result = strings.ReplaceAll(result, emoji, transformed)
+	return TransformTextWithOptions(ctx, text, targetFormat, Options{})
+}
+
+// TransformTextWithOptions is like TransformText, with options controlling
+// which emoji representations are recognized in the text.
+//
+// Example:
+//
+//	text := "Nice :) see http://example.com"
+//	result := TransformTextWithOptions(ctx, text, FormatEmoji, Options{Emoticons: true})
+//	// result: "Nice 😊 see http://example.com"
+func TransformTextWithOptions(ctx context.Context, text string, targetFormat Format, opts Options) string {
+	segments := replaceEmojis(ctx, text, opts, func(name string) (string, error) {
+		if targetFormat == FormatCodepoint && opts.CodepointSeparator != "" {
+			return TransformCodepoint(name, opts.CodepointSeparator)
 		}
+		return Transform(name, targetFormat)
+	})
+
+	// Convert emojis missing from the table
+	if opts.Fallback {
+		segments = replaceUnknownEmojis(segments, opts.Escapes, func(codepoints []rune) (string, bool) {
+			return encodeFallback(codepoints, targetFormat, opts)
+		})
+	}
+	return joinSegments(segments)
+}
+
+// replaceEmojis replaces every emoji found in text, in any supported format,
+// with the result of replace for the emoji's name, and returns the segments
+// of the result. Emojis that cannot be replaced are left untouched and the
+// failure is added to the log fields.
+//
+// Each pass only scans the parts of text that earlier passes kept, so the
+// output of a replacement is never replaced again.
+func replaceEmojis(ctx context.Context, text string, opts Options, replace func(name string) (string, error)) []segment {
+	locales, err := matchLocales(opts.Locales)
+	if err != nil {
+		gobserve.AddLogFields(ctx, zap.Error(fmt.Errorf("ignoring locales: %w", err)))
 	}
 
-	// Transform shortcodes
-	shortcodeRegex := regexp.MustCompile(`:[a-zA-Z_]+:`)
-	result = shortcodeRegex.ReplaceAllStringFunc(result, func(match string) string {
-		if name, exists := shortcodeToName[match]; exists {
-			transformed, err := Transform(name, targetFormat)
-			if err != nil {
-				gobserve.AddLogFields(
-					ctx,
-					zap.Error(fmt.Errorf("transformation for shortcode %q with name %q failed: %w", match, name, err)),
-				)
-				return match // Return original if transformation fails
-			}
-			return transformed
+	var passes []func(text string) []segment
+
+	// Transform emoticons first, while URLs are still intact
+	if opts.Emoticons {
+		passes = append(passes, func(text string) []segment {
+			return replaceEmoticons(ctx, text, replace)
+		})
+	}
+
+	passes = append(passes,
+		// Transform actual emojis, one whole grapheme cluster at a time
+		func(text string) []segment {
+			return replaceEmojiClusters(ctx, text, replace)
+		},
+		// Transform shortcodes
+		func(text string) []segment {
+			return replaceShortcodes(ctx, text, locales, replace)
+		},
+		// Transform HTML entities
+		func(text string) []segment {
+			return replaceHTML(ctx, text, replace)
+		},
+	)
+
+	// Transform escapes and formats added with RegisterFormat
+	for _, codec := range textCodecs(opts.Escapes) {
+		passes = append(passes, func(text string) []segment {
+			return replaceWithCodec(ctx, text, codec, replace)
+		})
+	}
+
+	segments := []segment{{text: text}}
+	for _, pass := range passes {
+		segments = applyPass(segments, pass)
+	}
+	return segments
+}
+
+// replaceEmojiClusters replaces every emoji grapheme cluster in text that is
+// a supported emoji. Clusters that are not, such as an unknown skin tone
+// variant of a supported emoji, are kept whole.
+func replaceEmojiClusters(ctx context.Context, text string, replace func(name string) (string, error)) []segment {
+	var b segmentBuilder
+	last := 0
+	for _, cluster := range findEmojiClusters(text) {
+		emoji := text[cluster[0]:cluster[1]]
+		name, exists := lookupEmoji(emoji)
+		if !exists {
+			continue
 		}
-		return match
-	})
+		transformed, err := replace(name)
+		if err != nil {
+			gobserve.AddLogFields(
+				ctx,
+				zap.Error(fmt.Errorf("transformation for emoji %q with name %q failed: %w", emoji, name, err)),
+			)
+			continue // Keep the emoji if transformation fails
+		}
+		b.keep(text[last:cluster[0]])
+		b.replace(transformed)
+		last = cluster[1]
+	}
+	b.keep(text[last:])
+	return b.segments
+}
 
-	// Transform HTML entities
-	htmlRegex := regexp.MustCompile(`&#x[0-9a-fA-F]+;(?:&#x[0-9a-fA-F]+;)*`)
-	result = htmlRegex.ReplaceAllStringFunc(result, func(match string) string {
-		if name, exists := htmlToName[match]; exists {
-			transformed, err := Transform(name, targetFormat)
-			if err != nil {
-				gobserve.AddLogFields(
-					ctx,
-					zap.Error(fmt.Errorf("transformation for HTML %q with name %q failed: %w", match, name, err)),
-				)
-				return match
-			}
-			return transformed
+// replaceShortcodes replaces every known shortcode in text, including those
+// of locales.
+func replaceShortcodes(ctx context.Context, text string, locales []language.Tag, replace func(name string) (string, error)) []segment {
+	var b segmentBuilder
+	last := 0
+	for _, span := range shortcodeRegex.FindAllStringIndex(text, -1) {
+		match := text[span[0]:span[1]]
+		name, exists := lookupShortcode(match, locales)
+		if !exists {
+			continue
 		}
-		return match
-	})
+		transformed, err := replace(name)
+		if err != nil {
+			gobserve.AddLogFields(
+				ctx,
+				zap.Error(fmt.Errorf("transformation for shortcode %q with name %q failed: %w", match, name, err)),
+			)
+			continue // Keep the original if transformation fails
+		}
+		b.keep(text[last:span[0]])
+		b.replace(transformed)
+		last = span[1]
+	}
+	b.keep(text[last:])
+	return b.segments
+}
 
-	return result
+// replaceHTML replaces every run of HTML entities in text that is exactly
+// the HTML representation of a supported emoji.
+func replaceHTML(ctx context.Context, text string, replace func(name string) (string, error)) []segment {
+	htmlRegex := regexp.MustCompile(`&#x[0-9a-fA-F]+;(?:&#x[0-9a-fA-F]+;)*`)
+
+	var b segmentBuilder
+	last := 0
+	for _, span := range htmlRegex.FindAllStringIndex(text, -1) {
+		match := text[span[0]:span[1]]
+		name, exists := htmlToName[match]
+		if !exists {
+			continue
+		}
+		transformed, err := replace(name)
+		if err != nil {
+			gobserve.AddLogFields(
+				ctx,
+				zap.Error(fmt.Errorf("transformation for HTML %q with name %q failed: %w", match, name, err)),
+			)
+			continue
+		}
+		b.keep(text[last:span[0]])
+		b.replace(transformed)
+		last = span[1]
+	}
+	b.keep(text[last:])
+	return b.segments
 }
 
 // GetSupportedEmojis returns a list of all supported emoji names.
@@ -195,13 +395,16 @@ func GetSupportedEmojis() []string {
 //
 // The input can be in any supported format (name, emoji, shortcode, HTML, unicode).
 // Returns a Mapping struct containing all format representations of the emoji.
+// Its Qualification is that of the input, so "❤" is reported as unqualified
+// while Emoji holds the fully-qualified "❤️".
 func GetEmojiInfo(input string) (*Mapping, error) {
 	name := findEmojiName(input)
 	if name == "" {
-		return nil, fmt.Errorf("emoji not found: %s", input)
+		return nil, newNotFoundError(input)
 	}
 
-	mapping := emojiMappings[name]
+	mapping := mappingOf(name)
+	mapping.Qualification = qualificationOf(input, mapping)
 	return &mapping, nil
 }
 
@@ -214,6 +417,11 @@ func IsSupported(input string) bool {
 
 // findEmojiName attempts to identify the emoji name from various input formats.
 func findEmojiName(input string) string {
+	return resolveEmojiName(input, nil)
+}
+
+// resolveEmojiName is findEmojiName, also recognizing the shortcodes of locales.
+func resolveEmojiName(input string, locales []language.Tag) string {
 	// Clean input
 	input = strings.TrimSpace(input)
 
@@ -222,13 +430,13 @@ func findEmojiName(input string) string {
 		return input
 	}
 
-	// Check if it's an actual emoji
-	if name, exists := emojiToName[input]; exists {
+	// Check if it's an actual emoji, with or without variation selectors
+	if name, exists := lookupEmoji(input); exists {
 		return name
 	}
 
-	// Check if it's a shortcode
-	if name, exists := shortcodeToName[input]; exists {
+	// Check if it's a shortcode, including those of the given locales
+	if name, exists := lookupShortcode(input, locales); exists {
 		return name
 	}
 
@@ -251,9 +459,19 @@ func findEmojiName(input string) string {
 		return name
 	}
 
+	// Check if it's an ASCII emoticon
+	if name, exists := emoticonToName[input]; exists {
+		return name
+	}
+
+	// Check if it's escaped or in a format added with RegisterFormat
+	if name, exists := decodeWithCodecs(input); exists {
+		return name
+	}
+
 	// Try to match shortcode without colons
 	shortcodeWithColons := fmt.Sprintf(":%s:", input)
-	if name, exists := shortcodeToName[shortcodeWithColons]; exists {
+	if name, exists := lookupShortcode(shortcodeWithColons, locales); exists {
 		return name
 	}
 
@@ -0,0 +1,53 @@
+package gomoji
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf16"
+)
+
+// jsEscapeRegex matches runs of JavaScript/JSON \uXXXX escapes.
+var jsEscapeRegex = regexp.MustCompile(`(?:\\u[0-9a-fA-F]{4})+`)
+
+// encodeJSEscape encodes s as JavaScript/JSON escapes, using UTF-16
+// surrogate pairs for code points outside the Basic Multilingual Plane.
+func encodeJSEscape(s string) string {
+	var b strings.Builder
+	for _, unit := range utf16.Encode([]rune(s)) {
+		fmt.Fprintf(&b, "\\u%04X", unit)
+	}
+	return b.String()
+}
+
+// parseJSEscapes decodes a run of \uXXXX escapes into code points, combining
+// surrogate pairs and marking unpaired surrogates as invalid.
+func parseJSEscapes(run string) []escapedRune {
+	var units []uint16
+	for i := 0; i+6 <= len(run); i += 6 {
+		unit, _ := strconv.ParseUint(run[i+2:i+6], 16, 16)
+		units = append(units, uint16(unit))
+	}
+
+	var items []escapedRune
+	for i := 0; i < len(units); i++ {
+		unit := rune(units[i])
+		source := run[i*6 : i*6+6]
+		switch {
+		case utf16.IsSurrogate(unit) && i+1 < len(units):
+			if r := utf16.DecodeRune(unit, rune(units[i+1])); r != unicode.ReplacementChar {
+				items = append(items, escapedRune{r: r, source: run[i*6 : i*6+12]})
+				i++
+				continue
+			}
+			items = append(items, escapedRune{r: -1, source: source})
+		case utf16.IsSurrogate(unit):
+			items = append(items, escapedRune{r: -1, source: source})
+		default:
+			items = append(items, escapedRune{r: unit, source: source})
+		}
+	}
+	return items
+}
@@ -0,0 +1,105 @@
+package gomoji
+
+import (
+	"context"
+	"testing"
+)
+
+func TestTransformJSEscape(t *testing.T) {
+	tests := []struct {
+		name         string
+		input        string
+		targetFormat Format
+		expected     string
+	}{
+		{"emoji to escape", "😄", FormatJSEscape, `\uD83D\uDE04`},
+		{"variation selector kept", "❤️", FormatJSEscape, `\u2764\uFE0F`},
+		{"ZWJ sequence", "👩🏽‍🏫", FormatJSEscape, `\uD83D\uDC69\uD83C\uDFFD\u200D\uD83C\uDFEB`},
+		{"escape to emoji", `\uD83D\uDE04`, FormatEmoji, "😄"},
+		{"lowercase escape", `\ud83d\ude04`, FormatShortcode, ":smile:"},
+		{"escape without variation selector", `\u2764`, FormatEmoji, "❤️"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Transform(tt.input, tt.targetFormat)
+			if err != nil {
+				t.Fatalf("Transform(%s) returned error: %v", tt.input, err)
+			}
+			if result != tt.expected {
+				t.Errorf("Transform(%s) = %s, expected %s", tt.input, result, tt.expected)
+			}
+		})
+	}
+
+	invalid := []string{
+		`\uD83D`,        // unpaired high surrogate
+		`\uDE04\uD83D`,  // surrogates in the wrong order
+		`\uD83DA`,       // high surrogate followed by a BMP character
+		`x\uD83D\uDE04`, // not only escapes
+	}
+	for _, input := range invalid {
+		if IsSupported(input) {
+			t.Errorf("IsSupported(%s) = true, expected false", input)
+		}
+	}
+}
+
+func TestTransformTextJSEscape(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name         string
+		input        string
+		targetFormat Format
+		expected     string
+	}{
+		{
+			name:         "adjacent escaped emojis",
+			input:        `{"text":"hi \uD83D\uDE04\uD83D\uDD25"}`,
+			targetFormat: FormatShortcode,
+			expected:     `{"text":"hi :smile::fire:"}`,
+		},
+		{
+			name:         "other escapes are kept",
+			input:        `caf\u00e9 \uD83D\uDC4D`,
+			targetFormat: FormatEmoji,
+			expected:     `caf\u00e9 👍`,
+		},
+		{
+			name:         "unpaired surrogate is kept",
+			input:        `broken \uD83D ok \uD83D\uDE04`,
+			targetFormat: FormatEmoji,
+			expected:     `broken \uD83D ok 😄`,
+		},
+		{
+			name:         "emoji to escape",
+			input:        "JSON: 😄 ❤️",
+			targetFormat: FormatJSEscape,
+			expected:     `JSON: \uD83D\uDE04 \u2764\uFE0F`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := TransformText(ctx, tt.input, tt.targetFormat)
+			if result != tt.expected {
+				t.Errorf("TransformText(%q) = %q, expected %q", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestEncodeJSEscapeSurrogates(t *testing.T) {
+	for name, mapping := range emojiMappings {
+		encoded := encodeJSEscape(mapping.Emoji)
+		decoded, ok := formatCodecs[FormatJSEscape].Decode(encoded)
+		if !ok {
+			t.Errorf("%s: %s does not decode", name, encoded)
+			continue
+		}
+		if string(decoded) != mapping.Emoji {
+			t.Errorf("%s: %s decodes to %q, expected %q", name, encoded, string(decoded), mapping.Emoji)
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package gomoji
+
+import (
+	"context"
+	"testing"
+)
+
+func TestTransformKeycaps(t *testing.T) {
+	tests := []struct {
+		name         string
+		input        string
+		targetFormat Format
+		expected     string
+	}{
+		{"hash shortcode to emoji", ":hash:", FormatEmoji, "#\uFE0F\u20E3"},
+		{"digit shortcode to emoji", ":one:", FormatEmoji, "1\uFE0F\u20E3"},
+		{"asterisk alias", ":keycap_star:", FormatEmoji, "*\uFE0F\u20E3"},
+		{"keycap to shortcode", "0\uFE0F\u20E3", FormatShortcode, ":zero:"},
+		{"keycap without variation selector", "9\u20E3", FormatShortcode, ":nine:"},
+		{"keycap ten", "🔟", FormatShortcode, ":keycap_ten:"},
+		{"keycap to HTML", ":hash:", FormatHTML, "&#x23;&#xfe0f;&#x20e3;"},
+		{"keycap to unicode", ":seven:", FormatUnicode, `\U00000037\uFE0F\u20E3`},
+		{"HTML to keycap", "&#x35;&#xfe0f;&#x20e3;", FormatShortcode, ":five:"},
+		{"keycap description", ":hash:", FormatDescription, "keycap: #"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Transform(tt.input, tt.targetFormat)
+			if err != nil {
+				t.Fatalf("Transform(%s) returned error: %v", tt.input, err)
+			}
+			if result != tt.expected {
+				t.Errorf("Transform(%s) = %s, expected %s", tt.input, result, tt.expected)
+			}
+		})
+	}
+
+	for _, input := range []string{"1", "#", "*", "10"} {
+		if IsSupported(input) {
+			t.Errorf("IsSupported(%q) = true, expected false", input)
+		}
+	}
+}
+
+func TestTransformTextKeycaps(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name         string
+		input        string
+		targetFormat Format
+		expected     string
+	}{
+		{
+			name:         "plain digits and hash are untouched",
+			input:        "Call 911, ticket #42, 2*3",
+			targetFormat: FormatShortcode,
+			expected:     "Call 911, ticket #42, 2*3",
+		},
+		{
+			name:         "keycaps among digits",
+			input:        "Press 1\uFE0F\u20E3 then #\uFE0F\u20E3 on 42",
+			targetFormat: FormatShortcode,
+			expected:     "Press :one: then :hash: on 42",
+		},
+		{
+			name:         "keycap shortcodes to emoji",
+			input:        "Step :one: of :keycap_ten:",
+			targetFormat: FormatEmoji,
+			expected:     "Step 1\uFE0F\u20E3 of 🔟",
+		},
+		{
+			name:         "keycaps to HTML",
+			input:        "1\uFE0F\u20E32\uFE0F\u20E3",
+			targetFormat: FormatHTML,
+			expected:     "&#x31;&#xfe0f;&#x20e3;&#x32;&#xfe0f;&#x20e3;",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := TransformText(ctx, tt.input, tt.targetFormat)
+			if result != tt.expected {
+				t.Errorf("TransformText(%q) = %q, expected %q", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,190 @@
+package gomoji
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/language"
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+// supportedLanguages lists the languages with CLDR annotations, English first.
+var supportedLanguages = []language.Tag{language.English, language.Spanish, language.Portuguese}
+
+// localizedAnnotations holds the CLDR annotations of every supported language.
+var localizedAnnotations = map[language.Tag]map[string]annotation{
+	language.English:    emojiAnnotations,
+	language.Spanish:    emojiAnnotationsES,
+	language.Portuguese: emojiAnnotationsPT,
+}
+
+// localeMatcher matches regional and script variants (es-MX, pt-BR) to the
+// supported languages.
+var localeMatcher = language.NewMatcher(supportedLanguages)
+
+// SupportedLanguages returns the languages that have localized emoji names.
+func SupportedLanguages() []language.Tag {
+	return append([]language.Tag(nil), supportedLanguages...)
+}
+
+// GetEmojiInfoLocalized returns information about an emoji with its shortcode,
+// description and keywords in the given language.
+//
+// The input can be in any supported format, and may also be a shortcode of
+// lang. HTML and unicode representations are the same in every language.
+//
+// Example:
+//
+//	info, _ := GetEmojiInfoLocalized("😄", language.Spanish)
+//	// info.Shortcode: ":sonrisa:"
+//	// info.Description: "cara sonriendo con ojos sonrientes"
+func GetEmojiInfoLocalized(input string, lang language.Tag) (*Mapping, error) {
+	tag, err := matchLanguage(lang)
+	if err != nil {
+		return nil, err
+	}
+
+	name := findEmojiName(input)
+	if name == "" {
+		name = findLocalizedName(input, tag)
+	}
+	if name == "" {
+		return nil, newNotFoundError(input)
+	}
+
+	mapping := localizeMapping(name, tag)
+	return &mapping, nil
+}
+
+// SearchLocalized is like Search, but matches the localized shortcodes, names
+// and keywords of a language and returns localized mappings.
+//
+// Accents are ignored, so "corazon" finds "corazón".
+func SearchLocalized(query string, lang language.Tag, limit int) []Mapping {
+	tag, err := matchLanguage(lang)
+	if err != nil {
+		return nil
+	}
+	if tag == language.English {
+		return Search(query, limit)
+	}
+
+	query = foldAccents(normalizeQuery(query))
+	if query == "" {
+		return nil
+	}
+
+	var matches []searchMatch
+	for name, ann := range localizedAnnotations[tag] {
+		if _, exists := emojiMappings[name]; !exists {
+			continue
+		}
+		keys := []string{strings.Trim(ann.Shortcode, ":"), foldAccents(normalizeQuery(ann.Name))}
+		for _, keyword := range ann.Keywords {
+			keys = append(keys, foldAccents(normalizeQuery(keyword)))
+		}
+		if match, ok := bestMatch(query, name, keys); ok {
+			matches = append(matches, match)
+		}
+	}
+
+	sortMatches(matches)
+	if limit > 0 && len(matches) > limit {
+		matches = matches[:limit]
+	}
+
+	results := make([]Mapping, 0, len(matches))
+	for _, match := range matches {
+		results = append(results, localizeMapping(match.name, tag))
+	}
+	return results
+}
+
+// matchLanguage returns the supported language closest to lang.
+func matchLanguage(lang language.Tag) (language.Tag, error) {
+	_, index, confidence := localeMatcher.Match(lang)
+	if confidence == language.No {
+		return language.Und, fmt.Errorf("unsupported language: %s", lang)
+	}
+	return supportedLanguages[index], nil
+}
+
+// matchLocales returns the supported languages closest to langs, in order,
+// skipping English, whose shortcodes are always recognized. Unsupported
+// languages are left out and reported in the error.
+func matchLocales(langs []language.Tag) ([]language.Tag, error) {
+	var tags []language.Tag
+	var errs []error
+	for _, lang := range langs {
+		tag, err := matchLanguage(lang)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if tag != language.English {
+			tags = append(tags, tag)
+		}
+	}
+	return tags, errors.Join(errs...)
+}
+
+// lookupShortcode resolves a shortcode (with colons) to an emoji name, trying
+// English first and then the localized shortcodes of every locale, which must
+// be supported languages, in order.
+func lookupShortcode(shortcode string, locales []language.Tag) (string, bool) {
+	if name, exists := shortcodeToName[shortcode]; exists {
+		return name, true
+	}
+
+	for _, tag := range locales {
+		if name, exists := localeShortcodeToName[tag][shortcode]; exists {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+// findLocalizedName resolves a localized shortcode of lang, with or without colons.
+func findLocalizedName(input string, lang language.Tag) string {
+	input = strings.TrimSpace(input)
+	shortcodes := localeShortcodeToName[lang]
+	if name, exists := shortcodes[input]; exists {
+		return name
+	}
+	if name, exists := shortcodes[":"+input+":"]; exists {
+		return name
+	}
+	return ""
+}
+
+// localizeMapping returns the mapping of an emoji with the shortcode,
+// description and keywords of lang.
+func localizeMapping(name string, lang language.Tag) Mapping {
+	mapping := mappingOf(name)
+	if lang == language.English {
+		return mapping
+	}
+
+	if ann, exists := localizedAnnotations[lang][name]; exists {
+		mapping.Description = ann.Name
+		mapping.Keywords = append([]string(nil), ann.Keywords...)
+		if ann.Shortcode != "" {
+			mapping.Shortcode = ann.Shortcode
+		}
+	}
+	return mapping
+}
+
+// foldAccents removes diacritics so accented and plain spellings match.
+func foldAccents(s string) string {
+	t := transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+	folded, _, err := transform.String(t, s)
+	if err != nil {
+		return s
+	}
+	return folded
+}
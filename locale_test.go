@@ -0,0 +1,179 @@
+package gomoji
+
+import (
+	"context"
+	"testing"
+
+	"golang.org/x/text/language"
+)
+
+func TestGetEmojiInfoLocalized(t *testing.T) {
+	tests := []struct {
+		name                string
+		input               string
+		lang                language.Tag
+		expectedEmoji       string
+		expectedShortcode   string
+		expectedDescription string
+	}{
+		{
+			name:                "spanish from emoji",
+			input:               "😄",
+			lang:                language.Spanish,
+			expectedEmoji:       "😄",
+			expectedShortcode:   ":sonrisa:",
+			expectedDescription: "cara sonriendo con ojos sonrientes",
+		},
+		{
+			name:                "spanish from localized shortcode",
+			input:               ":corazon:",
+			lang:                language.Spanish,
+			expectedEmoji:       "❤️",
+			expectedShortcode:   ":corazon:",
+			expectedDescription: "corazón rojo",
+		},
+		{
+			name:                "regional variant",
+			input:               "smile",
+			lang:                language.MustParse("es-MX"),
+			expectedEmoji:       "😄",
+			expectedShortcode:   ":sonrisa:",
+			expectedDescription: "cara sonriendo con ojos sonrientes",
+		},
+		{
+			name:                "brazilian portuguese",
+			input:               ":thumbs_up:",
+			lang:                language.BrazilianPortuguese,
+			expectedEmoji:       "👍",
+			expectedShortcode:   ":joinha:",
+			expectedDescription: "polegar para cima",
+		},
+		{
+			name:                "english",
+			input:               "😄",
+			lang:                language.English,
+			expectedEmoji:       "😄",
+			expectedShortcode:   ":smile:",
+			expectedDescription: "grinning face with smiling eyes",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info, err := GetEmojiInfoLocalized(tt.input, tt.lang)
+			if err != nil {
+				t.Fatalf("GetEmojiInfoLocalized(%s, %s) returned error: %v", tt.input, tt.lang, err)
+			}
+			if info.Emoji != tt.expectedEmoji {
+				t.Errorf("Emoji = %s, expected %s", info.Emoji, tt.expectedEmoji)
+			}
+			if info.Shortcode != tt.expectedShortcode {
+				t.Errorf("Shortcode = %s, expected %s", info.Shortcode, tt.expectedShortcode)
+			}
+			if info.Description != tt.expectedDescription {
+				t.Errorf("Description = %s, expected %s", info.Description, tt.expectedDescription)
+			}
+		})
+	}
+
+	if _, err := GetEmojiInfoLocalized("smile", language.Japanese); err == nil {
+		t.Error("expected error for unsupported language")
+	}
+	if _, err := GetEmojiInfoLocalized(":no_existe:", language.Spanish); err == nil {
+		t.Error("expected error for unknown localized shortcode")
+	}
+}
+
+func TestOptionsLocales(t *testing.T) {
+	ctx := context.Background()
+	spanish := Options{Locales: []language.Tag{language.Spanish}}
+
+	if IsSupported(":sonrisa:") {
+		t.Fatal("localized shortcode resolved without a locale")
+	}
+
+	result, err := TransformWithOptions(":sonrisa:", FormatEmoji, spanish)
+	if err != nil {
+		t.Fatalf("TransformWithOptions(:sonrisa:) returned error: %v", err)
+	}
+	if result != "😄" {
+		t.Errorf("TransformWithOptions(:sonrisa:) = %s, expected 😄", result)
+	}
+
+	text := TransformTextWithOptions(ctx, "Hola :sonrisa: :fuego:", FormatShortcode, spanish)
+	if text != "Hola :smile: :fire:" {
+		t.Errorf("TransformTextWithOptions = %q, expected %q", text, "Hola :smile: :fire:")
+	}
+
+	// Locales apply to a single call only
+	if IsSupported(":sonrisa:") {
+		t.Error("localized shortcode resolved after a call with a locale")
+	}
+	if text := TransformText(ctx, "Hola :sonrisa:", FormatEmoji); text != "Hola :sonrisa:" {
+		t.Errorf("TransformText = %q, expected %q", text, "Hola :sonrisa:")
+	}
+
+	japanese := Options{Locales: []language.Tag{language.Japanese, language.Spanish}}
+	if _, err := TransformWithOptions(":sonrisa:", FormatEmoji, japanese); err == nil {
+		t.Error("expected error for an unsupported language")
+	}
+	if text := TransformTextWithOptions(ctx, ":sonrisa:", FormatEmoji, japanese); text != "😄" {
+		t.Errorf("TransformTextWithOptions = %q, expected %q", text, "😄")
+	}
+}
+
+func TestSearchLocalized(t *testing.T) {
+	tests := []struct {
+		name              string
+		query             string
+		lang              language.Tag
+		expectedShortcode string
+	}{
+		{"spanish shortcode", "sonrisa", language.Spanish, ":sonrisa:"},
+		{"spanish without accents", "corazon roto", language.Spanish, ":corazon_roto:"},
+		{"spanish keyword", "gato", language.Spanish, ":gato:"},
+		{"portuguese misspelled", "joinah", language.Portuguese, ":joinha:"},
+		{"english", "smille", language.English, ":smile:"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results := SearchLocalized(tt.query, tt.lang, 3)
+			if len(results) == 0 {
+				t.Fatalf("SearchLocalized(%q, %s) returned no results", tt.query, tt.lang)
+			}
+			if results[0].Shortcode != tt.expectedShortcode {
+				t.Errorf("SearchLocalized(%q, %s)[0] = %s, expected %s", tt.query, tt.lang, results[0].Shortcode, tt.expectedShortcode)
+			}
+		})
+	}
+}
+
+func TestLocalizedAnnotations(t *testing.T) {
+	for _, lang := range SupportedLanguages() {
+		if lang == language.English {
+			continue
+		}
+		annotations := localizedAnnotations[lang]
+		seen := make(map[string]string)
+		for name := range emojiMappings {
+			ann, exists := annotations[name]
+			if !exists {
+				t.Errorf("%s: emoji %q has no annotation", lang, name)
+				continue
+			}
+			if ann.Name == "" || ann.Shortcode == "" || len(ann.Keywords) == 0 {
+				t.Errorf("%s: emoji %q has an incomplete annotation: %+v", lang, name, ann)
+			}
+			if other, exists := seen[ann.Shortcode]; exists {
+				t.Errorf("%s: shortcode %s is used by both %q and %q", lang, ann.Shortcode, name, other)
+			}
+			seen[ann.Shortcode] = name
+
+			// A localized shortcode may only match an English one for the same emoji
+			if english, exists := shortcodeToName[ann.Shortcode]; exists && english != name {
+				t.Errorf("%s: shortcode %s of %q is the English shortcode of %q", lang, ann.Shortcode, name, english)
+			}
+		}
+	}
+}
@@ -1,6 +1,12 @@
 package gomoji
 
-import "strings"
+import (
+	"sort"
+	"strings"
+
+	"golang.org/x/text/language"
+	"golang.org/x/text/unicode/runenames"
+)
 
 // Reverse mappings for quick lookups from any format to emoji name
 var (
@@ -8,17 +14,50 @@ var (
 	shortcodeToName map[string]string
 	htmlToName      map[string]string
 	unicodeToName   map[string]string
+	emoticonToName  map[string]string
+	// emojiBaseToName maps emojis with their variation selectors removed
+	emojiBaseToName map[string]string
 )
 
+// emoticonsByLength holds every emoticon, longest first, so text scanning
+// prefers ">:(" over ":("
+var emoticonsByLength []string
+
+// Indexes for autocompletion
+var (
+	// shortcodeIndex holds every shortcode and alias without colons, sorted by key
+	shortcodeIndex []indexEntry
+	// popularityRank maps an emoji name to its position in emojiPopularity
+	popularityRank map[string]int
+)
+
+// localeShortcodeToName maps each localized language to its shortcodes
+var localeShortcodeToName map[language.Tag]map[string]string
+
+// keywordIndex maps a lowercase keyword, and every word within it, to the
+// names of the emojis annotated with it
+var keywordIndex map[string][]string
+
 // init initializes reverse mappings for fast emoji lookups.
 func init() {
 	emojiToName = make(map[string]string)
 	shortcodeToName = make(map[string]string)
 	htmlToName = make(map[string]string)
 	unicodeToName = make(map[string]string)
+	emojiBaseToName = make(map[string]string)
+
+	// Complete the hand-picked flags with every region flag
+	addFlagMappings()
 
 	for name, mapping := range emojiMappings {
+		// Derive the HTML and Unicode representations from the code points
+		mapping.HTML = encodeHTML(mapping.Emoji)
+		mapping.Unicode = encodeUnicode(mapping.Emoji)
+		mapping.Qualification = QualificationFullyQualified
+		emojiMappings[name] = mapping
+
 		emojiToName[mapping.Emoji] = name
+		emojiBaseToName[stripVariationSelectors(mapping.Emoji)] = name
 		shortcodeToName[mapping.Shortcode] = name
 		htmlToName[mapping.HTML] = name
 		unicodeToName[mapping.Unicode] = name
@@ -40,4 +79,164 @@ func init() {
 			unicodeToName[unicodeBase] = name
 		}
 	}
+
+	// Register alternative shortcodes, without overriding any primary shortcode
+	for name, aliases := range emojiAliases {
+		for _, alias := range aliases {
+			shortcode := ":" + alias + ":"
+			if _, exists := shortcodeToName[shortcode]; !exists {
+				shortcodeToName[shortcode] = name
+			}
+		}
+	}
+
+	buildCompletionIndex()
+	buildKeywordIndex()
+	buildLocaleIndexes()
+	buildEmoticonIndex()
+	buildPythonNameIndex()
+	buildVariantIndex()
+}
+
+// buildCompletionIndex builds the sorted shortcode index and popularity ranks
+// used by Complete.
+func buildCompletionIndex() {
+	shortcodeIndex = make([]indexEntry, 0, len(shortcodeToName))
+	for shortcode, name := range shortcodeToName {
+		shortcodeIndex = append(shortcodeIndex, indexEntry{key: strings.Trim(shortcode, ":"), name: name})
+	}
+	sort.Slice(shortcodeIndex, func(i, j int) bool {
+		return shortcodeIndex[i].key < shortcodeIndex[j].key
+	})
+
+	popularityRank = make(map[string]int, len(emojiPopularity))
+	for rank, name := range emojiPopularity {
+		popularityRank[name] = rank
+	}
+}
+
+// buildKeywordIndex copies CLDR short names and keywords into the mappings and
+// indexes the keywords for SearchByKeyword.
+func buildKeywordIndex() {
+	keywordIndex = make(map[string][]string)
+	for name, ann := range emojiAnnotations {
+		mapping, exists := emojiMappings[name]
+		if !exists {
+			continue
+		}
+		mapping.Description = ann.Name
+		mapping.Keywords = ann.Keywords
+		emojiMappings[name] = mapping
+
+		seen := make(map[string]bool)
+		for _, keyword := range ann.Keywords {
+			keyword = strings.ToLower(keyword)
+			words := strings.FieldsFunc(keyword, func(r rune) bool {
+				return r == ' ' || r == '-' || r == ':'
+			})
+			for _, key := range append([]string{keyword}, words...) {
+				if !seen[key] {
+					seen[key] = true
+					keywordIndex[key] = append(keywordIndex[key], name)
+				}
+			}
+		}
+	}
+}
+
+// buildLocaleIndexes builds the reverse shortcode mappings for every localized language.
+func buildLocaleIndexes() {
+	localeShortcodeToName = make(map[language.Tag]map[string]string)
+	for lang, annotations := range localizedAnnotations {
+		if lang == language.English {
+			continue
+		}
+		shortcodes := make(map[string]string, len(annotations))
+		for name, ann := range annotations {
+			if _, exists := emojiMappings[name]; exists && ann.Shortcode != "" {
+				shortcodes[ann.Shortcode] = name
+			}
+		}
+		localeShortcodeToName[lang] = shortcodes
+	}
+}
+
+// buildEmoticonIndex builds the reverse emoticon mapping and the emoticon scan order.
+func buildEmoticonIndex() {
+	emoticonToName = make(map[string]string)
+	emoticonsByLength = nil
+	for name, emoticons := range emojiEmoticons {
+		for _, emoticon := range emoticons {
+			emoticonToName[emoticon] = name
+			emoticonsByLength = append(emoticonsByLength, emoticon)
+		}
+	}
+	sort.Slice(emoticonsByLength, func(i, j int) bool {
+		if len(emoticonsByLength[i]) != len(emoticonsByLength[j]) {
+			return len(emoticonsByLength[i]) > len(emoticonsByLength[j])
+		}
+		return emoticonsByLength[i] < emoticonsByLength[j]
+	})
+}
+
+// mappingOf returns a copy of the mapping of the emoji name, with its own
+// keywords, so callers can't change the package's emoji data.
+func mappingOf(name string) Mapping {
+	mapping := emojiMappings[name]
+	mapping.Keywords = append([]string(nil), mapping.Keywords...)
+	return mapping
+}
+
+// lookupEmoji resolves an actual emoji to its name, also accepting it with
+// missing or extra variation selectors.
+func lookupEmoji(emoji string) (string, bool) {
+	if name, exists := emojiToName[emoji]; exists {
+		return name, true
+	}
+	name, exists := emojiBaseToName[stripVariationSelectors(emoji)]
+	return name, exists
+}
+
+// stripVariationSelectors removes text (U+FE0E) and emoji (U+FE0F)
+// presentation selectors from s.
+func stripVariationSelectors(s string) string {
+	if !strings.ContainsAny(s, "\uFE0E\uFE0F") {
+		return s
+	}
+	return strings.NewReplacer("\uFE0E", "", "\uFE0F", "").Replace(s)
+}
+
+// buildPythonNameIndex maps the Unicode character name of every code point
+// that can appear in an emoji sequence, and every emoji's CLDR short name, to
+// its code points. Unicode names take precedence.
+func buildPythonNameIndex() {
+	pythonNameToRunes = make(map[string][]rune)
+	addRange := func(lo, hi, stride rune) {
+		for r := lo; r <= hi; r += stride {
+			if name := runenames.Name(r); name != "" {
+				pythonNameToRunes[name] = []rune{r}
+			}
+		}
+	}
+	for _, rng := range extendedPictographic.R16 {
+		addRange(rune(rng.Lo), rune(rng.Hi), rune(rng.Stride))
+	}
+	for _, rng := range extendedPictographic.R32 {
+		addRange(rune(rng.Lo), rune(rng.Hi), rune(rng.Stride))
+	}
+	addRange(0x1F1E6, 0x1F1FF, 1) // Regional indicators
+	addRange(0x1F3FB, 0x1F3FF, 1) // Skin tone modifiers
+	addRange(0xE0020, 0xE007F, 1) // Tags
+	for _, mapping := range emojiMappings {
+		for _, r := range mapping.Emoji {
+			pythonNameToRunes[runenames.Name(r)] = []rune{r}
+		}
+	}
+
+	for _, mapping := range emojiMappings {
+		name := strings.ToUpper(mapping.Description)
+		if _, exists := pythonNameToRunes[name]; !exists {
+			pythonNameToRunes[name] = []rune(mapping.Emoji)
+		}
+	}
 }
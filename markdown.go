@@ -0,0 +1,388 @@
+package gomoji
+
+import (
+	"context"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Regexes for Markdown autolinks (<https://example.com>, <user@example.com>),
+// link reference definitions ([id]: https://example.com) and ATX headings
+var (
+	markdownAutolinkRegex  = regexp.MustCompile(`^<(?:[A-Za-z][A-Za-z0-9+.\-]{1,31}:[^\s<>]*|[A-Za-z0-9.!#$%&'*+/=?^_{|}~\-]+@[A-Za-z0-9\-]+(?:\.[A-Za-z0-9\-]+)*)>`)
+	markdownReferenceRegex = regexp.MustCompile(`^ {0,3}\[[^\]]+\]:`)
+	markdownHeadingRegex   = regexp.MustCompile(`^ {0,3}#{1,6}(?:[ \t]|$)`)
+)
+
+// TransformMarkdown is like TransformText for Markdown text, leaving code
+// untouched: code spans, fenced and indented code blocks, also inside list
+// items and blockquotes, link and image URLs, link reference definitions and
+// autolinks, including bare URLs.
+// Emojis everywhere else, including link text, are transformed.
+//
+// Example:
+//
+//	text := TransformMarkdown(ctx, "Use `:smile:` for :smile:", FormatEmoji)
+//	// text: "Use `:smile:` for 😄"
+func TransformMarkdown(ctx context.Context, text string, targetFormat Format) string {
+	var b strings.Builder
+	last := 0
+	for _, span := range markdownCodeSpans(text) {
+		b.WriteString(TransformText(ctx, text[last:span[0]], targetFormat))
+		b.WriteString(text[span[0]:span[1]])
+		last = span[1]
+	}
+	b.WriteString(TransformText(ctx, text[last:], targetFormat))
+	return b.String()
+}
+
+// markdownCodeSpans returns the start and end byte offsets, in order, of the
+// parts of Markdown text that must be left untouched.
+func markdownCodeSpans(text string) [][]int {
+	var spans [][]int
+
+	// Paragraphs are scanned for inline code and links once they end
+	paragraph := -1
+	flush := func(end int) {
+		if paragraph >= 0 {
+			spans = append(spans, inlineCodeSpans(text, paragraph, end)...)
+			paragraph = -1
+		}
+	}
+
+	var fence string
+	fenceStart, fenceDepth, fenceIndent := 0, 0, 0
+	var items []int // Content columns of the open list items, innermost last
+	depth := 0      // Blockquote depth of the open list items
+	for start := 0; start < len(text); {
+		end := strings.IndexByte(text[start:], '\n') + start + 1
+		if end == start {
+			end = len(text)
+		}
+		line := strings.TrimRight(text[start:end], "\r\n")
+
+		if fence != "" {
+			// Inside a fenced code block until the closing fence, or until
+			// the blockquote or list item holding it ends
+			lineDepth, inner := stripBlockquotes(line, fenceDepth)
+			if lineDepth == fenceDepth && (strings.TrimSpace(inner) == "" || indentation(inner) >= fenceIndent) {
+				if isClosingFence(stripColumns(inner, fenceIndent), fence) {
+					spans = append(spans, []int{fenceStart, end})
+					fence = ""
+				}
+				start = end
+				continue
+			}
+			spans = append(spans, []int{fenceStart, start})
+			fence = ""
+		}
+
+		lineDepth, inner := stripBlockquotes(line, -1)
+		if strings.TrimSpace(inner) == "" {
+			flush(start)
+			start = end
+			continue
+		}
+		if lineDepth != depth {
+			if paragraph >= 0 && lineDepth < depth {
+				// A lazy continuation of a paragraph in a blockquote
+				start = end
+				continue
+			}
+			items, depth = nil, lineDepth
+		}
+
+		// The content of the line inside its list item
+		var started bool
+		items, started = openListItems(items, inner, paragraph >= 0)
+		content := stripColumns(inner, listItemIndent(items))
+		if started {
+			content = stripColumns(blankListMarker(inner), listItemIndent(items))
+		}
+
+		switch {
+		case openingFence(content) != "":
+			flush(start)
+			fence = openingFence(content)
+			fenceStart, fenceDepth, fenceIndent = start, depth, listItemIndent(items)
+		case paragraph < 0 && indentation(content) >= 4:
+			// Indented code can't interrupt a paragraph
+			spans = append(spans, []int{start, end})
+		case paragraph < 0 && markdownReferenceRegex.MatchString(content):
+			spans = append(spans, []int{start, end})
+		case markdownHeadingRegex.MatchString(content):
+			// A heading is a paragraph of its own line
+			flush(start)
+			spans = append(spans, inlineCodeSpans(text, start, end)...)
+		case paragraph < 0:
+			paragraph = start
+		}
+		start = end
+	}
+
+	if fence != "" {
+		// An unclosed fenced code block runs to the end of the text
+		spans = append(spans, []int{fenceStart, len(text)})
+	}
+	flush(len(text))
+	return spans
+}
+
+// stripBlockquotes removes up to limit blockquote markers (>) from the start
+// of line, or all of them when limit is negative, and returns how many it
+// removed and the rest of the line.
+func stripBlockquotes(line string, limit int) (int, string) {
+	depth := 0
+	for depth != limit && indentation(line) <= 3 {
+		rest := strings.TrimLeft(line, " ")
+		if !strings.HasPrefix(rest, ">") {
+			break
+		}
+		line = rest[1:]
+		if strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t") {
+			line = line[1:] // The optional space after the marker
+		}
+		depth++
+	}
+	return depth, line
+}
+
+// stripColumns removes up to columns columns of indentation from line.
+func stripColumns(line string, columns int) string {
+	column := 0
+	for i, c := range line {
+		if column >= columns {
+			return line[i:]
+		}
+		switch c {
+		case ' ':
+			column++
+		case '\t':
+			next := column + 4 - column%4
+			if next > columns {
+				// Keep the rest of a tab spanning past columns as spaces
+				return strings.Repeat(" ", next-columns) + line[i+1:]
+			}
+			column = next
+		default:
+			return line[i:]
+		}
+	}
+	return ""
+}
+
+// inlineCodeSpans returns the spans of code spans, link destinations and
+// autolinks in text[start:end], and of URLs outside them.
+func inlineCodeSpans(text string, start, end int) [][]int {
+	var spans [][]int
+	for i := start; i < end; {
+		switch text[i] {
+		case '\\':
+			// Backslash escapes, as in \`, are literal characters
+			i += 2
+		case '`':
+			n := backtickRunLength(text[i:end])
+			if closing := closingBacktickRun(text[i+n:end], n); closing >= 0 {
+				spans = append(spans, []int{i, i + n + closing + n})
+				i += n + closing + n
+				continue
+			}
+			i += n
+		case '<':
+			if m := markdownAutolinkRegex.FindStringIndex(text[i:end]); m != nil {
+				spans = append(spans, []int{i, i + m[1]})
+				i += m[1]
+				continue
+			}
+			i++
+		case ']':
+			if i+1 < end && text[i+1] == '(' {
+				if closing := linkDestinationEnd(text[i+2 : end]); closing >= 0 {
+					spans = append(spans, []int{i + 2, i + 2 + closing})
+					i += 2 + closing
+					continue
+				}
+			}
+			i++
+		default:
+			i++
+		}
+	}
+
+	// Bare URLs outside code spans and links
+	for _, url := range urlRegex.FindAllStringIndex(text[start:end], -1) {
+		url = []int{start + url[0], start + url[1]}
+		overlaps := false
+		for _, span := range spans {
+			if url[0] < span[1] && span[0] < url[1] {
+				overlaps = true
+				break
+			}
+		}
+		if !overlaps {
+			spans = append(spans, url)
+		}
+	}
+	sort.Slice(spans, func(i, j int) bool { return spans[i][0] < spans[j][0] })
+	return spans
+}
+
+// openListItems returns the content columns of the list items open at the
+// non-blank line, given those open before it, and whether line starts one.
+// A line starting a list item closes the items it isn't nested in. Any
+// other line closes the items it is indented less than, unless it continues
+// a paragraph.
+func openListItems(items []int, line string, continuation bool) ([]int, bool) {
+	column := indentation(line)
+	content, _, isItem := listItemContent(line)
+	if isItem && column >= listItemIndent(items)+4 {
+		isItem = false // Indented code
+	}
+	if !isItem && continuation {
+		return items, false
+	}
+
+	for len(items) > 0 && items[len(items)-1] > column {
+		items = items[:len(items)-1]
+	}
+	if isItem {
+		items = append(items, content)
+	}
+	return items, isItem
+}
+
+// listItemContent returns the column where the content of the list item
+// started by line begins and the byte offset where its marker ends, or false
+// if line doesn't start a list item.
+func listItemContent(line string) (int, int, bool) {
+	column := indentation(line)
+	rest := strings.TrimLeft(line, " \t")
+
+	marker := len(rest) - len(strings.TrimLeft(rest, "0123456789"))
+	switch {
+	case marker == 0 && rest != "" && strings.ContainsRune("-*+", rune(rest[0])):
+		marker = 1
+	case marker > 0 && marker <= 9 && marker < len(rest) && (rest[marker] == '.' || rest[marker] == ')'):
+		marker++
+	default:
+		return 0, 0, false
+	}
+	markerEnd := len(line) - len(rest) + marker
+
+	after := rest[marker:]
+	if strings.TrimSpace(after) == "" {
+		return column + marker + 1, markerEnd, true
+	}
+	spaces := indentation(after)
+	if spaces == 0 {
+		return 0, 0, false
+	}
+	if spaces > 4 {
+		spaces = 1 // The content is indented code
+	}
+	return column + marker + spaces, markerEnd, true
+}
+
+// blankListMarker replaces the indentation and marker of the list item
+// started by line with spaces, so its content can be read like that of the
+// item's other lines.
+func blankListMarker(line string) string {
+	_, markerEnd, _ := listItemContent(line)
+	return strings.Repeat(" ", indentation(line)+len(strings.TrimLeft(line[:markerEnd], " \t"))) + line[markerEnd:]
+}
+
+// listItemIndent returns the content column of the innermost list item, or
+// 0 outside lists.
+func listItemIndent(items []int) int {
+	if len(items) == 0 {
+		return 0
+	}
+	return items[len(items)-1]
+}
+
+// openingFence returns the backtick or tilde fence that line opens, or ""
+// if line doesn't open a fenced code block.
+func openingFence(line string) string {
+	if indentation(line) > 3 {
+		return ""
+	}
+	line = strings.TrimLeft(line, " ")
+	if !strings.HasPrefix(line, "```") && !strings.HasPrefix(line, "~~~") {
+		return ""
+	}
+	fence := line[:len(line)-len(strings.TrimLeft(line, line[:1]))]
+	if fence[0] == '`' && strings.Contains(line[len(fence):], "`") {
+		return "" // The info string of a backtick fence can't hold backticks
+	}
+	return fence
+}
+
+// isClosingFence reports whether line closes the fenced code block opened
+// with fence: a fence of the same character at least as long.
+func isClosingFence(line, fence string) bool {
+	if indentation(line) > 3 {
+		return false
+	}
+	line = strings.TrimSpace(line)
+	return len(line) >= len(fence) && strings.Trim(line, fence[:1]) == ""
+}
+
+// indentation returns the indentation of line in columns, with tab stops
+// every four columns.
+func indentation(line string) int {
+	columns := 0
+	for _, c := range line {
+		switch c {
+		case ' ':
+			columns++
+		case '\t':
+			columns += 4 - columns%4
+		default:
+			return columns
+		}
+	}
+	return columns
+}
+
+// backtickRunLength returns the number of backticks at the start of s.
+func backtickRunLength(s string) int {
+	return len(s) - len(strings.TrimLeft(s, "`"))
+}
+
+// closingBacktickRun returns the offset in s of the first run of exactly n
+// backticks, which closes a code span opened with n backticks, or -1.
+func closingBacktickRun(s string, n int) int {
+	for i := 0; i < len(s); {
+		if s[i] != '`' {
+			i++
+			continue
+		}
+		run := backtickRunLength(s[i:])
+		if run == n {
+			return i
+		}
+		i += run
+	}
+	return -1
+}
+
+// linkDestinationEnd returns the offset in s of the parenthesis closing a
+// link destination and title, allowing balanced parentheses within, or -1.
+func linkDestinationEnd(s string) int {
+	depth := 1
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
@@ -0,0 +1,199 @@
+package gomoji
+
+import (
+	"context"
+	"testing"
+)
+
+func TestTransformMarkdown(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "plain text",
+			input:    "Hello :smile:!",
+			expected: "Hello 😄!",
+		},
+		{
+			name:     "code span",
+			input:    "Use `:smile:` for :smile:",
+			expected: "Use `:smile:` for 😄",
+		},
+		{
+			name:     "double backtick code span",
+			input:    "``a ` :smile:`` :smile:",
+			expected: "``a ` :smile:`` 😄",
+		},
+		{
+			name:     "unclosed backticks",
+			input:    "`` :smile:",
+			expected: "`` 😄",
+		},
+		{
+			name:     "escaped backtick",
+			input:    "\\` :smile: `",
+			expected: "\\` 😄 `",
+		},
+		{
+			name:     "fenced code block",
+			input:    "Before :smile:\n```go\nfmt.Println(\":smile:\")\n```\nAfter :smile:",
+			expected: "Before 😄\n```go\nfmt.Println(\":smile:\")\n```\nAfter 😄",
+		},
+		{
+			name:     "tilde fence with longer closing fence",
+			input:    "~~~\n:smile:\n~~~~~\n:smile:",
+			expected: "~~~\n:smile:\n~~~~~\n😄",
+		},
+		{
+			name:     "shorter fence doesn't close",
+			input:    "````\n:smile:\n```\n:smile:\n````\n:smile:",
+			expected: "````\n:smile:\n```\n:smile:\n````\n😄",
+		},
+		{
+			name:     "unclosed fence",
+			input:    ":smile:\n```\n:smile:",
+			expected: "😄\n```\n:smile:",
+		},
+		{
+			name:     "indented code block",
+			input:    "Text :smile:\n\n    code :smile:\n\tmore :smile:\n\nText :smile:",
+			expected: "Text 😄\n\n    code :smile:\n\tmore :smile:\n\nText 😄",
+		},
+		{
+			name:     "indented paragraph continuation",
+			input:    "Text :smile:\n    more :smile:",
+			expected: "Text 😄\n    more 😄",
+		},
+		{
+			name:     "indented code after heading",
+			input:    "# Title :smile:\n    code :smile:",
+			expected: "# Title 😄\n    code :smile:",
+		},
+		{
+			name:     "list item paragraph",
+			input:    "- item :smile:\n\n    para :smile:",
+			expected: "- item 😄\n\n    para 😄",
+		},
+		{
+			name:     "nested list item paragraph",
+			input:    "1. item\n   - nested\n\n     para :smile:\n\n   back :smile:",
+			expected: "1. item\n   - nested\n\n     para 😄\n\n   back 😄",
+		},
+		{
+			name:     "indented code in list item",
+			input:    "- item\n\n      code :smile:",
+			expected: "- item\n\n      code :smile:",
+		},
+		{
+			name:     "fenced code in list item",
+			input:    "- ```\n  :smile:\n  ```\n",
+			expected: "- ```\n  :smile:\n  ```\n",
+		},
+		{
+			name:     "fenced code in list item paragraph",
+			input:    "- item :smile:\n\n  ```\n  :smile:\n  ```\n\n:smile:",
+			expected: "- item 😄\n\n  ```\n  :smile:\n  ```\n\n😄",
+		},
+		{
+			name:     "fenced code ends with its list item",
+			input:    "- ```\n  :smile:\n:smile:",
+			expected: "- ```\n  :smile:\n😄",
+		},
+		{
+			name:     "blockquote",
+			input:    "> quote :smile:\nlazy :smile:",
+			expected: "> quote 😄\nlazy 😄",
+		},
+		{
+			name:     "indented code in blockquote",
+			input:    "> code:\n>\n>     :smile:\n",
+			expected: "> code:\n>\n>     :smile:\n",
+		},
+		{
+			name:     "fenced code in blockquote",
+			input:    "> ```\n> :smile:\n> ```\nafter :smile:",
+			expected: "> ```\n> :smile:\n> ```\nafter 😄",
+		},
+		{
+			name:     "fenced code ends with its blockquote",
+			input:    "> ```\n> :smile:\n\n:smile:",
+			expected: "> ```\n> :smile:\n\n😄",
+		},
+		{
+			name:     "blockquote in fenced code",
+			input:    "```\n> ```\n:smile:\n```",
+			expected: "```\n> ```\n:smile:\n```",
+		},
+		{
+			name:     "indented code after list",
+			input:    "- item\n\nText\n\n    code :smile:",
+			expected: "- item\n\nText\n\n    code :smile:",
+		},
+		{
+			name:     "link URL",
+			input:    "[:smile: docs](https://example.com/:smile:/) :smile:",
+			expected: "[😄 docs](https://example.com/:smile:/) 😄",
+		},
+		{
+			name:     "image with title",
+			input:    "![:smile:](img/:smile:.png \":smile:\")",
+			expected: "![😄](img/:smile:.png \":smile:\")",
+		},
+		{
+			name:     "link with parentheses",
+			input:    "[wiki](https://en.wikipedia.org/wiki/Smile_(:smile:)) :smile:",
+			expected: "[wiki](https://en.wikipedia.org/wiki/Smile_(:smile:)) 😄",
+		},
+		{
+			name:     "reference definition",
+			input:    "[docs][ref] :smile:\n\n[ref]: https://example.com/:smile:",
+			expected: "[docs][ref] 😄\n\n[ref]: https://example.com/:smile:",
+		},
+		{
+			name:     "autolink",
+			input:    "<https://example.com/:smile:> :smile:",
+			expected: "<https://example.com/:smile:> 😄",
+		},
+		{
+			name:     "custom scheme autolink",
+			input:    "<smile::smile:> :smile:",
+			expected: "<smile::smile:> 😄",
+		},
+		{
+			name:     "email autolink",
+			input:    "<U+1F604@example.com> U+1F604",
+			expected: "<U+1F604@example.com> 😄",
+		},
+		{
+			name:     "bare URL",
+			input:    "See https://example.com/:smile: :smile:",
+			expected: "See https://example.com/:smile: 😄",
+		},
+		{
+			name:     "other formats",
+			input:    "`&#x1f604;` &#x1f604; 😄",
+			expected: "`&#x1f604;` 😄 😄",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := TransformMarkdown(ctx, tt.input, FormatEmoji)
+			if result != tt.expected {
+				t.Errorf("TransformMarkdown(%q) = %q, expected %q", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestTransformMarkdownToShortcode(t *testing.T) {
+	input := "Nice 😄\n\n```\nkeep 😄\n```\n\n`😄` and [😄](https://example.com)"
+	expected := "Nice :smile:\n\n```\nkeep 😄\n```\n\n`😄` and [:smile:](https://example.com)"
+	if result := TransformMarkdown(context.Background(), input, FormatShortcode); result != expected {
+		t.Errorf("TransformMarkdown(%q) = %q, expected %q", input, result, expected)
+	}
+}
@@ -0,0 +1,117 @@
+package gomoji
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// NormalizeMode selects the presentation Normalize rewrites emojis to.
+type NormalizeMode int
+
+const (
+	// NormalizeEmoji rewrites emojis to their fully-qualified form, adding the
+	// emoji presentation selector (U+FE0F) where the emoji needs it ("❤" → "❤️").
+	NormalizeEmoji NormalizeMode = iota
+	// NormalizeText rewrites emojis that have a text presentation to it, with
+	// the text presentation selector (U+FE0E) ("❤️" → "❤︎"). Emojis without
+	// one, such as 😄 or ZWJ sequences, are rewritten to their emoji form.
+	NormalizeText
+	// NormalizeMinimal rewrites emojis to their shortest form that still
+	// displays as an emoji, removing every emoji presentation selector except
+	// one following a first character that is text by default.
+	NormalizeMinimal
+)
+
+// Normalize rewrites every supported emoji in text to a canonical
+// presentation, so the same emoji is always stored with the same code points.
+//
+// Emojis are recognized with or without variation selectors, including
+// text-default characters such as a bare "❤". Emojis missing from the table
+// are kept as they are.
+//
+// Example:
+//
+//	text := Normalize("I ❤ Go ☀︎", NormalizeEmoji)
+//	// text: "I ❤️ Go ☀️"
+func Normalize(text string, mode NormalizeMode) string {
+	var b strings.Builder
+	for i := 0; i < len(text); {
+		n := presentationSequenceLength(text[i:])
+		if n == 0 {
+			_, size := utf8.DecodeRuneInString(text[i:])
+			b.WriteString(text[i : i+size])
+			i += size
+			continue
+		}
+		sequence := text[i : i+n]
+		if name, exists := lookupEmoji(sequence); exists {
+			sequence = normalizedForm(emojiMappings[name].Emoji, mode, sequence)
+		}
+		b.WriteString(sequence)
+		i += n
+	}
+	return b.String()
+}
+
+// normalizedForm returns the form of the fully-qualified emoji for mode, or
+// original when mode is not valid.
+func normalizedForm(emoji string, mode NormalizeMode, original string) string {
+	switch mode {
+	case NormalizeEmoji:
+		return emoji
+	case NormalizeText:
+		return textForm(emoji)
+	case NormalizeMinimal:
+		return minimalForm(emoji)
+	default:
+		return original
+	}
+}
+
+// textForm returns the text presentation of the fully-qualified emoji.
+// Only emojis made of a single element that need the emoji presentation
+// selector, such as ❤️ or #️⃣, have one; other emojis are returned unchanged.
+func textForm(emoji string) string {
+	if strings.Contains(emoji, zeroWidthJoiner) {
+		return emoji
+	}
+	return strings.Replace(emoji, string(emojiPresentationVS), string(textPresentation), 1)
+}
+
+// minimalForm returns the fully-qualified emoji without the emoji
+// presentation selectors it can be displayed without. Only a selector after a
+// first character that is text by default is needed.
+func minimalForm(emoji string) string {
+	first, size := utf8.DecodeRuneInString(emoji)
+	var b strings.Builder
+	b.WriteString(emoji[:size])
+	for i, r := range emoji[size:] {
+		if r == emojiPresentationVS && (i > 0 || unicode.Is(emojiPresentation, first)) {
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// presentationSequenceLength returns the byte length of the emoji sequence
+// at the start of s, as emojiClusterLength, also accepting pictographs and
+// keycaps in text presentation. It returns 0 if s doesn't start with one.
+func presentationSequenceLength(s string) int {
+	if n := emojiClusterLength(s); n > 0 {
+		return n
+	}
+	r, size := utf8.DecodeRuneInString(s)
+	switch {
+	case unicode.Is(extendedPictographic, r):
+		n, _ := emojiElementLength(s)
+		return n
+	case isKeycapBase(r):
+		next, nextSize := utf8.DecodeRuneInString(s[size:])
+		if next == textPresentation && strings.HasPrefix(s[size+nextSize:], combiningKeycap) {
+			return size + nextSize + len(combiningKeycap)
+		}
+	}
+	return 0
+}
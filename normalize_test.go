@@ -0,0 +1,81 @@
+package gomoji
+
+import "testing"
+
+func TestNormalize(t *testing.T) {
+	const (
+		heart      = "\u2764"
+		heartEmoji = "\u2764\uFE0F"
+		heartText  = "\u2764\uFE0E"
+		hash       = "#\u20E3"
+		hashEmoji  = "#\uFE0F\u20E3"
+		hashText   = "#\uFE0E\u20E3"
+		teacher    = "\U0001F469\U0001F3FD\u200D\U0001F3EB"
+	)
+
+	tests := []struct {
+		name     string
+		input    string
+		mode     NormalizeMode
+		expected string
+	}{
+		{"emoji adds selector", "I " + heart + " Go", NormalizeEmoji, "I " + heartEmoji + " Go"},
+		{"emoji replaces text selector", heartText, NormalizeEmoji, heartEmoji},
+		{"emoji keeps emoji form", heartEmoji, NormalizeEmoji, heartEmoji},
+		{"emoji keycap", hash + hashText, NormalizeEmoji, hashEmoji + hashEmoji},
+		{"emoji removes extra selector", "😄\uFE0F", NormalizeEmoji, "😄"},
+		{"text from emoji", heartEmoji, NormalizeText, heartText},
+		{"text from bare", heart, NormalizeText, heartText},
+		{"text keycap", hashEmoji, NormalizeText, hashText},
+		{"text without text presentation", "😄\uFE0F", NormalizeText, "😄"},
+		{"text ZWJ sequence", teacher, NormalizeText, teacher},
+		{"minimal keeps needed selector", heart, NormalizeMinimal, heartEmoji},
+		{"minimal from text", heartText, NormalizeMinimal, heartEmoji},
+		{"minimal removes extra selector", "😄\uFE0F", NormalizeMinimal, "😄"},
+		{"minimal keycap", hash, NormalizeMinimal, hashEmoji},
+		{"unknown emoji kept", "\u2660\uFE0F and \u00A9", NormalizeEmoji, "\u2660\uFE0F and \u00A9"},
+		{"plain text", "# 1 * no emojis", NormalizeEmoji, "# 1 * no emojis"},
+		{"invalid mode", heart + "😄\uFE0F", NormalizeMode(-1), heart + "😄\uFE0F"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := Normalize(tt.input, tt.mode)
+			if result != tt.expected {
+				t.Errorf("Normalize(%q, %d) = %q, expected %q", tt.input, tt.mode, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestMinimalForm(t *testing.T) {
+	tests := []struct {
+		emoji    string
+		expected string
+	}{
+		{"\U0001F3F3\uFE0F\u200D\U0001F308", "\U0001F3F3\uFE0F\u200D\U0001F308"},
+		{"\U0001F468\u200D\u2695\uFE0F", "\U0001F468\u200D\u2695"},
+		{"\U0001F441\uFE0F\u200D\U0001F5E8\uFE0F", "\U0001F441\uFE0F\u200D\U0001F5E8"},
+		{"\u261D\U0001F3FB", "\u261D\U0001F3FB"},
+	}
+
+	for _, tt := range tests {
+		if result := minimalForm(tt.emoji); result != tt.expected {
+			t.Errorf("minimalForm(%q) = %q, expected %q", tt.emoji, result, tt.expected)
+		}
+	}
+}
+
+func TestNormalizeIsIdempotent(t *testing.T) {
+	for _, mode := range []NormalizeMode{NormalizeEmoji, NormalizeText, NormalizeMinimal} {
+		for name, mapping := range emojiMappings {
+			once := Normalize(mapping.Emoji, mode)
+			if twice := Normalize(once, mode); twice != once {
+				t.Errorf("Normalize(%s) in mode %d is not idempotent: %q then %q", name, mode, once, twice)
+			}
+			if found, _ := lookupEmoji(once); found != name {
+				t.Errorf("Normalize(%s) in mode %d = %q, which resolves to %q", name, mode, once, found)
+			}
+		}
+	}
+}
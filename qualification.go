@@ -0,0 +1,71 @@
+package gomoji
+
+import "strings"
+
+// Qualification is the qualification status of an emoji sequence, as
+// defined by Unicode in emoji-test.txt. Platforms may display emojis that
+// are not fully qualified as text.
+type Qualification string
+
+const (
+	// QualificationFullyQualified is an emoji with every emoji presentation
+	// selector it needs (❤️).
+	QualificationFullyQualified Qualification = "fully-qualified"
+	// QualificationMinimallyQualified is an emoji sequence whose first
+	// character is displayed as an emoji, but that misses an emoji
+	// presentation selector later in the sequence.
+	QualificationMinimallyQualified Qualification = "minimally-qualified"
+	// QualificationUnqualified is an emoji that misses the emoji presentation
+	// selector of its first character (❤), or is written with other variation
+	// selectors than its fully-qualified form.
+	QualificationUnqualified Qualification = "unqualified"
+)
+
+// Qualify rewrites every supported emoji in text to its fully-qualified form.
+// It is equivalent to Normalize with NormalizeEmoji.
+//
+// Example:
+//
+//	text := Qualify("I ❤ Go")
+//	// text: "I ❤️ Go"
+func Qualify(text string) string {
+	return Normalize(text, NormalizeEmoji)
+}
+
+// qualificationOf returns the qualification of input, an emoji resolved to
+// mapping. Inputs that are not written with code points, such as names and
+// shortcodes, stand for the fully-qualified emoji.
+func qualificationOf(input string, mapping Mapping) Qualification {
+	codepoints, ok := inputCodepoints(strings.TrimSpace(input))
+	if !ok {
+		return QualificationFullyQualified
+	}
+	return sequenceQualification(string(codepoints), mapping.Emoji)
+}
+
+// sequenceQualification returns the qualification of sequence, a form of the
+// fully-qualified emoji with other variation selectors.
+func sequenceQualification(sequence, emoji string) Qualification {
+	switch sequence {
+	case emoji:
+		return QualificationFullyQualified
+	case minimalForm(emoji):
+		return QualificationMinimallyQualified
+	default:
+		return QualificationUnqualified
+	}
+}
+
+// inputCodepoints returns the code points of input when it is an emoji
+// written as is or in any encoding format.
+func inputCodepoints(input string) ([]rune, bool) {
+	if n := presentationSequenceLength(input); n > 0 && n == len(input) {
+		return []rune(input), true
+	}
+	for _, decode := range encodingDecoders() {
+		if codepoints, ok := decode(input); ok {
+			return codepoints, true
+		}
+	}
+	return nil, false
+}
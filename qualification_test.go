@@ -0,0 +1,80 @@
+package gomoji
+
+import "testing"
+
+func TestGetEmojiInfoQualification(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected Qualification
+	}{
+		{"\u2764\uFE0F", QualificationFullyQualified},
+		{"\u2764", QualificationUnqualified},
+		{"\u2764\uFE0E", QualificationUnqualified},
+		{"😄", QualificationFullyQualified},
+		{"😄\uFE0F", QualificationUnqualified},
+		{"#\uFE0F\u20E3", QualificationFullyQualified},
+		{"#\u20E3", QualificationUnqualified},
+		{"&#x2764;", QualificationUnqualified},
+		{"&#x2764;&#xfe0f;", QualificationFullyQualified},
+		{"\U0001F469\U0001F3FD\u200D\U0001F3EB", QualificationFullyQualified},
+		{"heart", QualificationFullyQualified},
+		{":heart:", QualificationFullyQualified},
+		{"<3", QualificationFullyQualified},
+	}
+
+	for _, tt := range tests {
+		info, err := GetEmojiInfo(tt.input)
+		if err != nil {
+			t.Fatalf("GetEmojiInfo(%q) returned error: %v", tt.input, err)
+		}
+		if info.Qualification != tt.expected {
+			t.Errorf("GetEmojiInfo(%q).Qualification = %s, expected %s", tt.input, info.Qualification, tt.expected)
+		}
+	}
+}
+
+func TestSequenceQualification(t *testing.T) {
+	const eyeInSpeechBubble = "\U0001F441\uFE0F\u200D\U0001F5E8\uFE0F"
+
+	tests := []struct {
+		sequence string
+		expected Qualification
+	}{
+		{eyeInSpeechBubble, QualificationFullyQualified},
+		{"\U0001F441\uFE0F\u200D\U0001F5E8", QualificationMinimallyQualified},
+		{"\U0001F441\u200D\U0001F5E8\uFE0F", QualificationUnqualified},
+		{"\U0001F441\u200D\U0001F5E8", QualificationUnqualified},
+	}
+
+	for _, tt := range tests {
+		if result := sequenceQualification(tt.sequence, eyeInSpeechBubble); result != tt.expected {
+			t.Errorf("sequenceQualification(%q) = %s, expected %s", tt.sequence, result, tt.expected)
+		}
+	}
+}
+
+func TestQualify(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"I \u2764 Go", "I \u2764\uFE0F Go"},
+		{"\u2600\uFE0E and \u2601", "\u2600\uFE0F and \u2601\uFE0F"},
+		{"7\u20E3 😄", "7\uFE0F\u20E3 😄"},
+		{"no emojis", "no emojis"},
+	}
+
+	for _, tt := range tests {
+		if result := Qualify(tt.input); result != tt.expected {
+			t.Errorf("Qualify(%q) = %q, expected %q", tt.input, result, tt.expected)
+		}
+	}
+}
+
+func TestMappingsAreFullyQualified(t *testing.T) {
+	for name, mapping := range emojiMappings {
+		if mapping.Qualification != QualificationFullyQualified {
+			t.Errorf("%s has qualification %s, expected %s", name, mapping.Qualification, QualificationFullyQualified)
+		}
+	}
+}
@@ -0,0 +1,252 @@
+package gomoji
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// maxSuggestions is the number of suggestions attached to a NotFoundError.
+const maxSuggestions = 3
+
+// NotFoundError is returned when an input cannot be resolved to a supported emoji.
+//
+// Suggestions holds the closest supported emojis for inputs that look like a
+// name or shortcode, so callers can offer a "did you mean" hint.
+type NotFoundError struct {
+	// Input is the original input that could not be resolved.
+	Input string
+	// Suggestions are the best Search matches for Input, best first.
+	Suggestions []Mapping
+}
+
+// Error implements the error interface.
+func (e *NotFoundError) Error() string {
+	if len(e.Suggestions) == 0 {
+		return fmt.Sprintf("emoji not found or not supported: %s", e.Input)
+	}
+	return fmt.Sprintf("emoji not found or not supported: %s (did you mean %s?)", e.Input, e.Suggestions[0].Shortcode)
+}
+
+// newNotFoundError builds a NotFoundError for input, including suggestions
+// when the input looks like a name or shortcode.
+func newNotFoundError(input string) *NotFoundError {
+	err := &NotFoundError{Input: input}
+	if isSearchable(normalizeQuery(input)) {
+		err.Suggestions = Search(input, maxSuggestions)
+	}
+	return err
+}
+
+// Search returns the emojis whose name, shortcode or aliases resemble the query.
+//
+// Results are ranked by exact match first, then prefix match, then substring
+// match and finally by edit distance. Ties prefer names and shortcodes over
+// aliases and are then broken alphabetically by name.
+// The query is case-insensitive and may include surrounding colons. A limit
+// of zero or less returns every match.
+//
+// Example:
+//
+//	results := Search("smille", 3)
+//	// results[0].Shortcode: ":smile:"
+func Search(query string, limit int) []Mapping {
+	query = normalizeQuery(query)
+	if query == "" {
+		return nil
+	}
+
+	var matches []searchMatch
+	for name, mapping := range emojiMappings {
+		keys := append([]string{name, strings.Trim(mapping.Shortcode, ":")}, emojiAliases[name]...)
+		if match, ok := bestMatch(query, name, keys); ok {
+			matches = append(matches, match)
+		}
+	}
+
+	sortMatches(matches)
+	if limit > 0 && len(matches) > limit {
+		matches = matches[:limit]
+	}
+
+	results := make([]Mapping, 0, len(matches))
+	for _, match := range matches {
+		results = append(results, mappingOf(match.name))
+	}
+	return results
+}
+
+// SearchByKeyword returns the emojis annotated with a CLDR keyword matching word.
+//
+// The match is case-insensitive and also finds the word inside multi-word
+// keywords, so "cat" matches both the "cat" keyword and "cat face". Emojis
+// whose keyword matches exactly come first, then results are ordered by
+// popularity and alphabetically by name.
+//
+// Example:
+//
+//	results := SearchByKeyword("fire")
+//	// results[0].Emoji: "🔥"
+func SearchByKeyword(word string) []Mapping {
+	word = strings.ToLower(strings.TrimSpace(word))
+	names := keywordIndex[word]
+	if len(names) == 0 {
+		return nil
+	}
+
+	exact := make(map[string]bool, len(names))
+	for _, name := range names {
+		for _, keyword := range emojiMappings[name].Keywords {
+			if strings.ToLower(keyword) == word {
+				exact[name] = true
+				break
+			}
+		}
+	}
+
+	sorted := append([]string(nil), names...)
+	sort.Slice(sorted, func(i, j int) bool {
+		if exact[sorted[i]] != exact[sorted[j]] {
+			return exact[sorted[i]]
+		}
+		ri, rj := completionRank(sorted[i]), completionRank(sorted[j])
+		if ri != rj {
+			return ri < rj
+		}
+		return sorted[i] < sorted[j]
+	})
+
+	results := make([]Mapping, 0, len(sorted))
+	for _, name := range sorted {
+		results = append(results, mappingOf(name))
+	}
+	return results
+}
+
+// Match kinds, from best to worst.
+const (
+	matchExact = iota
+	matchPrefix
+	matchSubstring
+	matchFuzzy
+)
+
+// searchMatch is a ranked search candidate. key is the position of the
+// matched key, so earlier keys (names, shortcodes) beat later ones (keywords).
+type searchMatch struct {
+	name     string
+	kind     int
+	distance int
+	key      int
+}
+
+// less reports whether m ranks before other.
+func (m searchMatch) less(other searchMatch) bool {
+	if m.kind != other.kind {
+		return m.kind < other.kind
+	}
+	if m.distance != other.distance {
+		return m.distance < other.distance
+	}
+	if m.key != other.key {
+		return m.key < other.key
+	}
+	return m.name < other.name
+}
+
+// sortMatches sorts search matches from best to worst.
+func sortMatches(matches []searchMatch) {
+	sort.Slice(matches, func(i, j int) bool {
+		return matches[i].less(matches[j])
+	})
+}
+
+// bestMatch returns the best ranked match of query against any of keys.
+func bestMatch(query, name string, keys []string) (searchMatch, bool) {
+	best := searchMatch{name: name, kind: -1}
+	for i, key := range keys {
+		candidate := searchMatch{name: name, key: i}
+		switch {
+		case key == query:
+			candidate.kind = matchExact
+		case strings.HasPrefix(key, query):
+			candidate.kind = matchPrefix
+			candidate.distance = len(key) - len(query)
+		case strings.Contains(key, query):
+			candidate.kind = matchSubstring
+			candidate.distance = len(key) - len(query)
+		default:
+			distance := editDistance(query, key)
+			if distance > maxEditDistance(query) {
+				continue
+			}
+			candidate.kind = matchFuzzy
+			candidate.distance = distance
+		}
+
+		if best.kind == -1 || candidate.less(best) {
+			best = candidate
+		}
+	}
+	return best, best.kind != -1
+}
+
+// maxEditDistance returns how many edits a fuzzy match may need for query.
+func maxEditDistance(query string) int {
+	switch n := len([]rune(query)); {
+	case n <= 4:
+		return 1
+	case n <= 8:
+		return 2
+	default:
+		return 3
+	}
+}
+
+// normalizeQuery lowercases a query and converts it to shortcode form.
+func normalizeQuery(query string) string {
+	query = strings.ToLower(strings.TrimSpace(query))
+	query = strings.Trim(query, ":")
+	return strings.ReplaceAll(query, " ", "_")
+}
+
+// isSearchable reports whether a normalized query looks like a name or shortcode.
+func isSearchable(query string) bool {
+	if query == "" {
+		return false
+	}
+	for _, r := range query {
+		if !(r >= 'a' && r <= 'z' || r >= '0' && r <= '9' || r == '_' || r == '+' || r == '-') {
+			return false
+		}
+	}
+	return true
+}
+
+// editDistance returns the edit distance between a and b, counting swapped
+// adjacent characters as a single edit (optimal string alignment distance).
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	beforePrevious := make([]int, len(rb)+1)
+	previous := make([]int, len(rb)+1)
+	current := make([]int, len(rb)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		current[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+			if i > 1 && j > 1 && ra[i-1] == rb[j-2] && ra[i-2] == rb[j-1] {
+				current[j] = min(current[j], beforePrevious[j-2]+1)
+			}
+		}
+		beforePrevious, previous, current = previous, current, beforePrevious
+	}
+	return previous[len(rb)]
+}
@@ -0,0 +1,254 @@
+package gomoji
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestSearch(t *testing.T) {
+	tests := []struct {
+		name          string
+		query         string
+		limit         int
+		expectedFirst string
+	}{
+		{
+			name:          "exact name",
+			query:         "smile",
+			limit:         5,
+			expectedFirst: ":smile:",
+		},
+		{
+			name:          "misspelled name",
+			query:         "smille",
+			limit:         3,
+			expectedFirst: ":smile:",
+		},
+		{
+			name:          "shortcode with colons",
+			query:         ":rocket:",
+			limit:         1,
+			expectedFirst: ":rocket:",
+		},
+		{
+			name:          "prefix match",
+			query:         "thumbs_u",
+			limit:         3,
+			expectedFirst: ":thumbs_up:",
+		},
+		{
+			name:          "alias",
+			query:         "thumbsup",
+			limit:         3,
+			expectedFirst: ":thumbs_up:",
+		},
+		{
+			name:          "case and spaces",
+			query:         "Heart Eyes",
+			limit:         3,
+			expectedFirst: ":heart_eyes:",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results := Search(tt.query, tt.limit)
+			if len(results) == 0 {
+				t.Fatalf("Search(%q) returned no results", tt.query)
+			}
+			if len(results) > tt.limit {
+				t.Errorf("Search(%q) returned %d results, limit was %d", tt.query, len(results), tt.limit)
+			}
+			if results[0].Shortcode != tt.expectedFirst {
+				t.Errorf("Search(%q)[0] = %s, expected %s", tt.query, results[0].Shortcode, tt.expectedFirst)
+			}
+		})
+	}
+}
+
+func TestSearchNoResults(t *testing.T) {
+	for _, query := range []string{"", "   ", "zzzzzzzzzzzz"} {
+		if results := Search(query, 5); len(results) != 0 {
+			t.Errorf("Search(%q) = %v, expected no results", query, results)
+		}
+	}
+}
+
+func TestSearchIsDeterministic(t *testing.T) {
+	first := Search("heart", 0)
+	for i := 0; i < 10; i++ {
+		results := Search("heart", 0)
+		if len(results) != len(first) {
+			t.Fatalf("Search returned %d results, expected %d", len(results), len(first))
+		}
+		for j := range results {
+			if results[j].Shortcode != first[j].Shortcode {
+				t.Fatalf("Search order changed at %d: %s != %s", j, results[j].Shortcode, first[j].Shortcode)
+			}
+		}
+	}
+}
+
+func TestNotFoundErrorSuggestions(t *testing.T) {
+	_, err := Transform("smille", FormatEmoji)
+
+	var notFound *NotFoundError
+	if !errors.As(err, &notFound) {
+		t.Fatalf("expected *NotFoundError, got %T: %v", err, err)
+	}
+	if len(notFound.Suggestions) == 0 {
+		t.Fatal("expected suggestions for misspelled input")
+	}
+	if notFound.Suggestions[0].Shortcode != ":smile:" {
+		t.Errorf("first suggestion = %s, expected :smile:", notFound.Suggestions[0].Shortcode)
+	}
+	if !strings.Contains(err.Error(), "did you mean :smile:?") {
+		t.Errorf("error %q does not include suggestion", err.Error())
+	}
+
+	_, err = GetEmojiInfo("&#x1f999;")
+	if !errors.As(err, &notFound) {
+		t.Fatalf("expected *NotFoundError, got %T: %v", err, err)
+	}
+	if len(notFound.Suggestions) != 0 {
+		t.Errorf("expected no suggestions for HTML input, got %v", notFound.Suggestions)
+	}
+}
+
+func TestAliases(t *testing.T) {
+	for name, aliases := range emojiAliases {
+		if _, exists := emojiMappings[name]; !exists {
+			t.Errorf("alias target %q is not a supported emoji", name)
+			continue
+		}
+		for _, alias := range aliases {
+			if _, exists := emojiMappings[alias]; exists {
+				t.Errorf("alias %q shadows an emoji name", alias)
+			}
+			result, err := Transform(":"+alias+":", FormatShortcode)
+			if err != nil {
+				t.Errorf("Transform(:%s:) returned error: %v", alias, err)
+				continue
+			}
+			if result != emojiMappings[name].Shortcode {
+				t.Errorf("Transform(:%s:) = %s, expected %s", alias, result, emojiMappings[name].Shortcode)
+			}
+		}
+	}
+}
+
+func BenchmarkSearch(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_ = Search("smille", 5)
+	}
+}
+
+func TestSearchByKeyword(t *testing.T) {
+	tests := []struct {
+		name          string
+		word          string
+		expectedFirst string
+		contains      []string
+	}{
+		{
+			name:          "single keyword",
+			word:          "fire",
+			expectedFirst: "🔥",
+		},
+		{
+			name:          "word inside keyword",
+			word:          "cat",
+			expectedFirst: "🐱",
+		},
+		{
+			name:          "case insensitive",
+			word:          "HAPPY",
+			expectedFirst: "😊",
+			contains:      []string{"😀", "😄", "😃"},
+		},
+		{
+			name:          "multi-word keyword",
+			word:          "red heart",
+			expectedFirst: "❤️",
+		},
+		{
+			name:          "country name",
+			word:          "colombia",
+			expectedFirst: "🇨🇴",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results := SearchByKeyword(tt.word)
+			if len(results) == 0 {
+				t.Fatalf("SearchByKeyword(%q) returned no results", tt.word)
+			}
+			if results[0].Emoji != tt.expectedFirst {
+				t.Errorf("SearchByKeyword(%q)[0] = %s, expected %s", tt.word, results[0].Emoji, tt.expectedFirst)
+			}
+			for _, expected := range tt.contains {
+				found := false
+				for _, result := range results {
+					if result.Emoji == expected {
+						found = true
+						break
+					}
+				}
+				if !found {
+					t.Errorf("SearchByKeyword(%q) does not include %s", tt.word, expected)
+				}
+			}
+		})
+	}
+
+	if results := SearchByKeyword("nonexistent"); len(results) != 0 {
+		t.Errorf("SearchByKeyword(nonexistent) = %v, expected no results", results)
+	}
+}
+
+func TestAnnotationsCoverAllEmojis(t *testing.T) {
+	for name := range emojiMappings {
+		ann, exists := emojiAnnotations[name]
+		if !exists {
+			t.Errorf("emoji %q has no annotation", name)
+			continue
+		}
+		if ann.Name == "" || len(ann.Keywords) == 0 {
+			t.Errorf("emoji %q has an incomplete annotation: %+v", name, ann)
+		}
+
+		info, err := GetEmojiInfo(name)
+		if err != nil {
+			t.Errorf("GetEmojiInfo(%s) returned error: %v", name, err)
+			continue
+		}
+		if len(info.Keywords) == 0 {
+			t.Errorf("GetEmojiInfo(%s).Keywords is empty", name)
+		}
+	}
+}
+
+func TestKeywordsAreCopied(t *testing.T) {
+	info, err := GetEmojiInfo("cat")
+	if err != nil {
+		t.Fatalf("GetEmojiInfo(cat) returned error: %v", err)
+	}
+	original := info.Keywords[0]
+	info.Keywords[0] = "changed"
+
+	again, _ := GetEmojiInfo("cat")
+	if again.Keywords[0] != original {
+		t.Errorf("changing GetEmojiInfo(cat).Keywords changed the emoji data: %q", again.Keywords[0])
+	}
+
+	results := SearchByKeyword("cat")
+	if len(results) == 0 {
+		t.Fatal("SearchByKeyword(cat) returned no results")
+	}
+	results[0].Keywords[0] = "changed"
+	if again := SearchByKeyword("cat"); again[0].Keywords[0] == "changed" {
+		t.Error("changing SearchByKeyword(cat) keywords changed the emoji data")
+	}
+}
@@ -0,0 +1,165 @@
+package gomoji
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Special code points of emoji sequences
+const (
+	zeroWidthJoiner     = "\u200D"
+	textPresentation    = '\uFE0E'
+	emojiPresentationVS = '\uFE0F'
+	combiningKeycap     = "\u20E3"
+	cancelTag           = '\U000E007F'
+)
+
+// isEmojiSequence reports whether s is exactly one valid emoji sequence, such
+// as a single emoji, a modifier, keycap, flag or tag sequence, or a ZWJ
+// sequence of them. The emoji doesn't need to be in the table.
+func isEmojiSequence(s string) bool {
+	n := emojiSequenceLength(s)
+	return n > 0 && n == len(s)
+}
+
+// emojiSequenceLength returns the byte length of the emoji sequence at the
+// start of s, or 0 if s doesn't start with one. Pictographs with a text
+// default, such as © without a variation selector, are not emoji sequences.
+func emojiSequenceLength(s string) int {
+	end, presented := emojiElementLength(s)
+	if end == 0 {
+		return 0
+	}
+	for strings.HasPrefix(s[end:], zeroWidthJoiner) {
+		n, _ := emojiElementLength(s[end+len(zeroWidthJoiner):])
+		if n == 0 {
+			break
+		}
+		end += len(zeroWidthJoiner) + n
+		presented = true
+	}
+	if !presented {
+		return 0
+	}
+	return end
+}
+
+// emojiElementLength returns the byte length of the emoji element at the
+// start of s, without ZWJ, and whether it is displayed as an emoji on its own.
+func emojiElementLength(s string) (int, bool) {
+	r, size := utf8.DecodeRuneInString(s)
+	switch {
+	case isRegionalIndicator(r):
+		next, nextSize := utf8.DecodeRuneInString(s[size:])
+		if isRegionalIndicator(next) {
+			return size + nextSize, true
+		}
+		return 0, false
+	case isKeycapBase(r):
+		n := size
+		if next, nextSize := utf8.DecodeRuneInString(s[n:]); next == emojiPresentationVS {
+			n += nextSize
+		}
+		if strings.HasPrefix(s[n:], combiningKeycap) {
+			return n + len(combiningKeycap), true
+		}
+		return 0, false
+	case unicode.Is(extendedPictographic, r):
+		n := size
+		// Code points assigned after the tables are emojis from a newer version
+		presented := unicode.Is(emojiPresentation, r) || unicode.Is(reservedPictographic, r)
+		next, nextSize := utf8.DecodeRuneInString(s[n:])
+		switch {
+		case next == textPresentation:
+			return n + nextSize, false
+		case next == emojiPresentationVS, isEmojiModifier(next):
+			n += nextSize
+			presented = true
+		}
+		if tags := tagSequenceLength(s[n:]); tags > 0 {
+			n += tags
+			presented = true
+		}
+		return n, presented
+	}
+	return 0, false
+}
+
+// tagSequenceLength returns the byte length of the tag characters and
+// terminating cancel tag at the start of s, or 0 if there are none.
+func tagSequenceLength(s string) int {
+	n := 0
+	for n < len(s) {
+		r, size := utf8.DecodeRuneInString(s[n:])
+		if r == cancelTag && n > 0 {
+			return n + size
+		}
+		if !isTag(r) {
+			return 0
+		}
+		n += size
+	}
+	return 0
+}
+
+// isRegionalIndicator reports whether r is a regional indicator symbol letter.
+func isRegionalIndicator(r rune) bool {
+	return r >= 0x1F1E6 && r <= 0x1F1FF
+}
+
+// isEmojiModifier reports whether r is a skin tone modifier.
+func isEmojiModifier(r rune) bool {
+	return r >= 0x1F3FB && r <= 0x1F3FF
+}
+
+// isKeycapBase reports whether r can start a keycap sequence.
+func isKeycapBase(r rune) bool {
+	return r == '#' || r == '*' || (r >= '0' && r <= '9')
+}
+
+// isTag reports whether r is a tag character, excluding the cancel tag.
+func isTag(r rune) bool {
+	return r >= 0xE0020 && r <= 0xE007E
+}
+
+// emojiClusterLength returns the byte length of the extended grapheme
+// cluster at the start of s when it starts with an emoji sequence, or 0
+// otherwise. Trailing combining marks, modifiers and joiners belong to the
+// cluster, so an emoji is never split from characters rendered with it.
+func emojiClusterLength(s string) int {
+	n := emojiSequenceLength(s)
+	if n == 0 {
+		return 0
+	}
+	for n < len(s) {
+		r, size := utf8.DecodeRuneInString(s[n:])
+		if !isGraphemeExtend(r) {
+			break
+		}
+		n += size
+	}
+	return n
+}
+
+// findEmojiClusters returns the start and end byte offsets of every emoji
+// grapheme cluster in text, in order.
+func findEmojiClusters(text string) [][]int {
+	var clusters [][]int
+	for i := 0; i < len(text); {
+		if n := emojiClusterLength(text[i:]); n > 0 {
+			clusters = append(clusters, []int{i, i + n})
+			i += n
+			continue
+		}
+		_, size := utf8.DecodeRuneInString(text[i:])
+		i += size
+	}
+	return clusters
+}
+
+// isGraphemeExtend reports whether r extends the preceding grapheme cluster.
+func isGraphemeExtend(r rune) bool {
+	return unicode.In(r, unicode.Mn, unicode.Me, unicode.Other_Grapheme_Extend) ||
+		isEmojiModifier(r) || string(r) == zeroWidthJoiner
+}
@@ -0,0 +1,93 @@
+package gomoji
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestFindEmojiClusters(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		expected []string
+	}{
+		{"single emoji", "hi 😄!", []string{"😄"}},
+		{"variation selector", "🎙️ on", []string{"🎙️"}},
+		{"skin tone modifier", "👍🏽👍", []string{"👍🏽", "👍"}},
+		{"ZWJ sequence", "👩‍💻 and 👨‍👩‍👧", []string{"👩‍💻", "👨‍👩‍👧"}},
+		{"keycap", "press #\uFE0F\u20E3 or 1\u20E3", []string{"#\uFE0F\u20E3", "1\u20E3"}},
+		{"flags pair up in order", "🇪🇸🇫🇷🇨", []string{"🇪🇸", "🇫🇷"}},
+		{"tag sequence", "\U0001F3F4\U000E0067\U000E0062\U000E0065\U000E006E\U000E0067\U000E007F!", []string{"\U0001F3F4\U000E0067\U000E0062\U000E0065\U000E006E\U000E0067\U000E007F"}},
+		{"text presentation is not an emoji", "© 2024 ©\uFE0E ©\uFE0F", []string{"©\uFE0F"}},
+		{"plain digits are not keycaps", "call 911 #1", nil},
+		{"trailing combining mark stays in the cluster", "😄\u0301 x", []string{"😄\u0301"}},
+		{"dangling joiner stays in the cluster", "😄\u200D x", []string{"😄\u200D"}},
+		{"lone modifier is not an emoji", "\U0001F3FD", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var clusters []string
+			for _, cluster := range findEmojiClusters(tt.text) {
+				clusters = append(clusters, tt.text[cluster[0]:cluster[1]])
+			}
+			if !reflect.DeepEqual(clusters, tt.expected) {
+				t.Errorf("findEmojiClusters(%q) = %q, expected %q", tt.text, clusters, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSupportedEmojisAreSequences(t *testing.T) {
+	for name, mapping := range emojiMappings {
+		if !isEmojiSequence(mapping.Emoji) {
+			t.Errorf("%s: %q is not a single emoji sequence", name, mapping.Emoji)
+		}
+	}
+}
+
+func TestTransformTextGraphemes(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name         string
+		input        string
+		targetFormat Format
+		expected     string
+	}{
+		{
+			name:         "unknown skin tone variant is kept whole",
+			input:        "ok 👍🏽 👍",
+			targetFormat: FormatShortcode,
+			expected:     "ok 👍🏽 :thumbs_up:",
+		},
+		{
+			name:         "unknown ZWJ sequence is kept whole",
+			input:        "👩🏽‍💻 💻",
+			targetFormat: FormatShortcode,
+			expected:     "👩🏽‍💻 :computer:",
+		},
+		{
+			name:         "emoji with combining mark is kept whole",
+			input:        "😄\u0301 😄",
+			targetFormat: FormatShortcode,
+			expected:     "😄\u0301 :smile:",
+		},
+		{
+			name:         "known ZWJ sequence",
+			input:        "Teacher 👩🏽‍🏫!",
+			targetFormat: FormatShortcode,
+			expected:     "Teacher :woman_teacher:!",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := TransformText(ctx, tt.input, tt.targetFormat)
+			if result != tt.expected {
+				t.Errorf("TransformText(%q) = %q, expected %q", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
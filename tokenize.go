@@ -0,0 +1,189 @@
+package gomoji
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// segment is a part of a text being transformed. Replaced segments hold the
+// output of a replacement, which later passes never scan again, so an emoji
+// already converted to one format isn't read back as another.
+type segment struct {
+	text     string
+	replaced bool
+}
+
+// segmentBuilder collects the segments of a transformed text, merging
+// consecutive parts that were kept as they were written.
+type segmentBuilder struct {
+	segments []segment
+}
+
+// keep appends text that was kept as it was written.
+func (b *segmentBuilder) keep(text string) {
+	if text == "" {
+		return
+	}
+	if n := len(b.segments); n > 0 && !b.segments[n-1].replaced {
+		b.segments[n-1].text += text
+		return
+	}
+	b.segments = append(b.segments, segment{text: text})
+}
+
+// replace appends the output of a replacement.
+func (b *segmentBuilder) replace(text string) {
+	b.segments = append(b.segments, segment{text: text, replaced: true})
+}
+
+// add appends segments.
+func (b *segmentBuilder) add(segments []segment) {
+	for _, seg := range segments {
+		if seg.replaced {
+			b.replace(seg.text)
+			continue
+		}
+		b.keep(seg.text)
+	}
+}
+
+// applyPass runs pass on the text of every segment that no earlier pass
+// replaced.
+func applyPass(segments []segment, pass func(text string) []segment) []segment {
+	var b segmentBuilder
+	for _, seg := range segments {
+		if seg.replaced {
+			b.replace(seg.text)
+			continue
+		}
+		b.add(pass(seg.text))
+	}
+	return b.segments
+}
+
+// joinSegments returns the text made of segments.
+func joinSegments(segments []segment) string {
+	var b strings.Builder
+	for _, seg := range segments {
+		b.WriteString(seg.text)
+	}
+	return b.String()
+}
+
+// splitUnits splits text into the units that truncation never cuts, as
+// start and end byte offsets covering text in order: emojis in any supported
+// format, and otherwise single characters with their combining marks.
+func splitUnits(text string) [][]int {
+	var units [][]int
+	i := 0
+	for _, span := range emojiSpans(text) {
+		units = appendCharacters(units, text, i, span[0])
+		units = append(units, span)
+		i = span[1]
+	}
+	return appendCharacters(units, text, i, len(text))
+}
+
+// appendCharacters appends the characters of text[start:end], each with the
+// combining marks and joiners that follow it, to units.
+func appendCharacters(units [][]int, text string, start, end int) [][]int {
+	for i := start; i < end; {
+		_, size := utf8.DecodeRuneInString(text[i:end])
+		n := size
+		for i+n < end {
+			r, size := utf8.DecodeRuneInString(text[i+n : end])
+			if !isGraphemeExtend(r) && r != textPresentation && r != emojiPresentationVS {
+				break
+			}
+			n += size
+		}
+		units = append(units, []int{i, i + n})
+		i += n
+	}
+	return units
+}
+
+// emojiSpans returns the start and end byte offsets of every emoji in text,
+// in order: emojis written as themselves, whether supported or not, known
+// shortcodes, and each emoji sequence within runs of HTML references, unicode
+// escapes and the escape formats. Spans found by the scanners of formats
+// added with RegisterFormat are kept whole.
+func emojiSpans(text string) [][]int {
+	var spans [][]int
+	for i := 0; i < len(text); {
+		if n := presentationSequenceLength(text[i:]); n > 0 {
+			spans = append(spans, []int{i, i + n})
+			i += n
+			continue
+		}
+		_, size := utf8.DecodeRuneInString(text[i:])
+		i += size
+	}
+
+	for _, span := range shortcodeRegex.FindAllStringIndex(text, -1) {
+		if _, exists := lookupShortcode(text[span[0]:span[1]], nil); exists {
+			spans = append(spans, span)
+		}
+	}
+
+	spans = append(spans, escapeSpans(text, htmlEscapeRegex, parseHTMLEscapes)...)
+	spans = append(spans, escapeSpans(text, unicodeEscapeRegex, parseUnicodeEscapes)...)
+	for _, codec := range registeredCodecs() {
+		if escapes, ok := codec.(escapeCodec); ok {
+			spans = append(spans, escapeSpans(text, escapes.re, escapes.parse)...)
+			continue
+		}
+		spans = append(spans, codec.Scan(text)...)
+	}
+
+	// Keep the first of overlapping spans, and the longest of those starting
+	// at the same offset
+	sort.Slice(spans, func(i, j int) bool {
+		if spans[i][0] != spans[j][0] {
+			return spans[i][0] < spans[j][0]
+		}
+		return spans[i][1] > spans[j][1]
+	})
+	var result [][]int
+	last := 0
+	for _, span := range spans {
+		if span[0] < last || span[1] > len(text) || span[0] >= span[1] {
+			continue
+		}
+		result = append(result, span)
+		last = span[1]
+	}
+	return result
+}
+
+// escapeSpans returns the spans of every escaped emoji sequence in the runs
+// of escapes matched by re, and of every other escape on its own.
+func escapeSpans(text string, re *regexp.Regexp, parse func(run string) []escapedRune) [][]int {
+	var spans [][]int
+	for _, run := range re.FindAllStringIndex(text, -1) {
+		items := parse(text[run[0]:run[1]])
+		offset := run[0]
+		for i := 0; i < len(items); {
+			var runes []rune
+			for _, item := range items[i:] {
+				if item.r < 0 {
+					break
+				}
+				runes = append(runes, item.r)
+			}
+			s := string(runes)
+			count := max(1, utf8.RuneCountInString(s[:presentationSequenceLength(s)]))
+
+			start, end := offset, offset
+			for _, item := range items[i : i+count] {
+				end = offset + len(item.source)
+				offset += len(item.source) + len(item.sep)
+			}
+			spans = append(spans, []int{start, end})
+			i += count
+		}
+	}
+	return spans
+}
@@ -0,0 +1,49 @@
+package gomoji
+
+import "unicode/utf8"
+
+// TruncateBytes returns text cut to at most max bytes, including the
+// ellipsis appended when text is cut. Emojis, HTML references, escapes and
+// shortcodes are kept or dropped whole, so a ZWJ family or "&#x1f604;" is
+// never cut in half. It returns an empty string if not even the ellipsis
+// fits in max.
+//
+// Example:
+//
+//	text := TruncateBytes("Hi 👩‍👩‍👧!", 10, "…")
+//	// text: "Hi …"
+func TruncateBytes(text string, max int, ellipsis string) string {
+	return truncateUnits(text, max, ellipsis, func(s string) int { return len(s) })
+}
+
+// TruncateRunes is like TruncateBytes, counting runes instead of bytes.
+//
+// Example:
+//
+//	text := TruncateRunes("Nice &#x1f604;", 10, "...")
+//	// text: "Nice ..."
+func TruncateRunes(text string, max int, ellipsis string) string {
+	return truncateUnits(text, max, ellipsis, utf8.RuneCountInString)
+}
+
+// truncateUnits returns text cut to the whole units of splitUnits that fit
+// in limit, as measured by size, followed by ellipsis. Text that fits is
+// returned unchanged.
+func truncateUnits(text string, limit int, ellipsis string, size func(s string) int) string {
+	if size(text) <= limit {
+		return text
+	}
+	budget := limit - size(ellipsis)
+	if budget < 0 {
+		return ""
+	}
+
+	used := 0
+	for _, unit := range splitUnits(text) {
+		used += size(text[unit[0]:unit[1]])
+		if used > budget {
+			return text[:unit[0]] + ellipsis
+		}
+	}
+	return text + ellipsis
+}
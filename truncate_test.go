@@ -0,0 +1,71 @@
+package gomoji
+
+import (
+	"testing"
+	"unicode/utf8"
+)
+
+func TestTruncateBytes(t *testing.T) {
+	const family = "\U0001F469\u200D\U0001F469\u200D\U0001F467"
+
+	tests := []struct {
+		name     string
+		input    string
+		max      int
+		ellipsis string
+		expected string
+	}{
+		{"fits", "hello", 5, "…", "hello"},
+		{"plain text", "hello world", 8, "...", "hello..."},
+		{"ZWJ family dropped whole", "Hi " + family + "!", 10, "…", "Hi …"},
+		{"ZWJ family kept whole", "Hi " + family + "!", 21, "", "Hi " + family},
+		{"HTML reference dropped whole", "Nice &#x1f604; day", 13, "", "Nice "},
+		{"HTML reference kept whole", "Nice &#x1f604; day", 15 + len("…") - 1, "…", "Nice &#x1f604;…"},
+		{"shortcode dropped whole", "Go :thumbs_up:", 10, "", "Go "},
+		{"multibyte character not split", "año", 2, "", "a"},
+		{"ellipsis doesn't fit", "hello", 2, "...", ""},
+		{"zero", "hello", 0, "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := TruncateBytes(tt.input, tt.max, tt.ellipsis)
+			if result != tt.expected {
+				t.Errorf("TruncateBytes(%q, %d, %q) = %q, expected %q", tt.input, tt.max, tt.ellipsis, result, tt.expected)
+			}
+			if len(result) > tt.max {
+				t.Errorf("TruncateBytes(%q, %d, %q) = %q, which is %d bytes", tt.input, tt.max, tt.ellipsis, result, len(result))
+			}
+		})
+	}
+}
+
+func TestTruncateRunes(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		max      int
+		ellipsis string
+		expected string
+	}{
+		{"fits", "año", 3, "…", "año"},
+		{"plain text", "hello world", 6, "…", "hello…"},
+		{"HTML reference dropped whole", "Nice &#x1f604;", 10, "...", "Nice ..."},
+		{"keycap kept whole", "#\uFE0F\u20E3 and more", 4, "…", "#\uFE0F\u20E3…"},
+		{"keycap dropped whole", "#\uFE0F\u20E3 and more", 3, "…", "…"},
+		{"escape sequence dropped whole", `Hi \u2764\uFE0F!`, 14, "", "Hi "},
+		{"Python escape dropped whole", `Hi \N{SMILING FACE WITH HALO}!`, 20, "", "Hi "},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := TruncateRunes(tt.input, tt.max, tt.ellipsis)
+			if result != tt.expected {
+				t.Errorf("TruncateRunes(%q, %d, %q) = %q, expected %q", tt.input, tt.max, tt.ellipsis, result, tt.expected)
+			}
+			if count := utf8.RuneCountInString(result); count > tt.max {
+				t.Errorf("TruncateRunes(%q, %d, %q) = %q, which is %d runes", tt.input, tt.max, tt.ellipsis, result, count)
+			}
+		})
+	}
+}
@@ -0,0 +1,118 @@
+package gomoji
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// maxUTF8MB3 is the highest code point MySQL's 3-byte utf8 (utf8mb3)
+// character set can store, the end of the Basic Multilingual Plane.
+const maxUTF8MB3 = 0xFFFF
+
+// EncodeForUTF8MB3 rewrites every emoji in text that has a code point outside
+// the Basic Multilingual Plane, such as 😄, to the fallback format, so text
+// fits MySQL utf8 (utf8mb3) columns. Emojis made of BMP code points, such as
+// ☀️, are kept. Emojis missing from the table, and those the fallback format
+// can't represent within the BMP, are written as HTML references, as is
+// every other character outside the BMP, such as a lone regional indicator
+// or skin tone modifier, so the result always fits.
+//
+// Use FormatShortcode or FormatHTML as fallback to restore the emojis with
+// DecodeFromUTF8MB3.
+//
+// Example:
+//
+//	text := EncodeForUTF8MB3("Hi 😄 ☀️", FormatShortcode)
+//	// text: "Hi :smile: ☀️"
+func EncodeForUTF8MB3(text string, fallback Format) string {
+	var b strings.Builder
+	for i := 0; i < len(text); {
+		n := presentationSequenceLength(text[i:])
+		if n == 0 {
+			r, size := utf8.DecodeRuneInString(text[i:])
+			if r > maxUTF8MB3 {
+				b.WriteString(encodeHTML(text[i : i+size]))
+			} else {
+				b.WriteString(text[i : i+size])
+			}
+			i += size
+			continue
+		}
+
+		sequence := text[i : i+n]
+		if fitsUTF8MB3(sequence) {
+			b.WriteString(sequence)
+		} else {
+			b.WriteString(encodeSupplementary(sequence, fallback))
+		}
+		i += n
+	}
+	return b.String()
+}
+
+// DecodeFromUTF8MB3 reverses EncodeForUTF8MB3, restoring the emojis written
+// as shortcodes or HTML references and every other character outside the
+// Basic Multilingual Plane written as an HTML reference. Shortcodes and
+// references of BMP emojis and characters are kept.
+//
+// Example:
+//
+//	text := DecodeFromUTF8MB3("Hi :smile: &#x2600;&#xfe0f;")
+//	// text: "Hi 😄 &#x2600;&#xfe0f;"
+func DecodeFromUTF8MB3(text string) string {
+	text = shortcodeRegex.ReplaceAllStringFunc(text, func(match string) string {
+		if name, exists := lookupShortcode(match, nil); exists && !fitsUTF8MB3(emojiMappings[name].Emoji) {
+			return emojiMappings[name].Emoji
+		}
+		return match
+	})
+
+	return htmlEscapeRegex.ReplaceAllStringFunc(text, func(run string) string {
+		items := parseHTMLEscapes(run)
+		var b strings.Builder
+		for i := 0; i < len(items); {
+			var runes []rune
+			for _, item := range items[i:] {
+				if item.r < 0 {
+					break
+				}
+				runes = append(runes, item.r)
+			}
+			s := string(runes)
+			if n := presentationSequenceLength(s); n > 0 && !fitsUTF8MB3(s[:n]) {
+				b.WriteString(s[:n])
+				i += utf8.RuneCountInString(s[:n])
+				continue
+			}
+			if items[i].r > maxUTF8MB3 {
+				b.WriteString(string(items[i].r) + items[i].sep)
+			} else {
+				b.WriteString(items[i].source + items[i].sep)
+			}
+			i++
+		}
+		return b.String()
+	})
+}
+
+// encodeSupplementary writes the emoji sequence in the fallback format, or
+// as HTML references when that isn't possible within the BMP.
+func encodeSupplementary(sequence string, fallback Format) string {
+	if name, exists := lookupEmoji(sequence); exists {
+		if encoded, err := Transform(name, fallback); err == nil && fitsUTF8MB3(encoded) {
+			return encoded
+		}
+	}
+	return encodeHTML(sequence)
+}
+
+// fitsUTF8MB3 reports whether every code point of s is in the Basic
+// Multilingual Plane.
+func fitsUTF8MB3(s string) bool {
+	for _, r := range s {
+		if r > maxUTF8MB3 {
+			return false
+		}
+	}
+	return true
+}
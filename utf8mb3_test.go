@@ -0,0 +1,131 @@
+package gomoji
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestEncodeForUTF8MB3(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		fallback Format
+		expected string
+	}{
+		{"shortcode", "Hi 😄!", FormatShortcode, "Hi :smile:!"},
+		{"HTML", "Hi 😄!", FormatHTML, "Hi &#x1f604;!"},
+		{"BMP emoji kept", "\u2600\uFE0F and \u2764\uFE0F", FormatShortcode, "\u2600\uFE0F and \u2764\uFE0F"},
+		{"keycap kept", "#\uFE0F\u20E3", FormatShortcode, "#\uFE0F\u20E3"},
+		{"ZWJ sequence", "\U0001F469\u200D\U0001F692", FormatShortcode, ":woman_firefighter:"},
+		{"BMP emoji in supplementary sequence", "\U0001F937\u200D\u2640\uFE0F", FormatShortcode, ":woman_shrugging:"},
+		{"text-default supplementary pictograph", "\U0001F399", FormatShortcode, ":studio_microphone:"},
+		{"unknown emoji as HTML", "\U0001FAE8", FormatShortcode, "&#x1fae8;"},
+		{"invalid fallback as HTML", "😄", Format("invalid"), "&#x1f604;"},
+		{"supplementary fallback as HTML", "😄", FormatEmoji, "&#x1f604;"},
+		{"description", "😄", FormatDescription, "grinning face with smiling eyes"},
+		{"plain text", "año 日本", FormatShortcode, "año 日本"},
+		{"lone regional indicator", "\U0001F1E6 x", FormatShortcode, "&#x1f1e6; x"},
+		{"orphan skin tone", "x \U0001F3FD", FormatShortcode, "x &#x1f3fd;"},
+		{"non-BMP letter", "\U0001D49C", FormatShortcode, "&#x1d49c;"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := EncodeForUTF8MB3(tt.input, tt.fallback)
+			if result != tt.expected {
+				t.Errorf("EncodeForUTF8MB3(%q, %s) = %q, expected %q", tt.input, tt.fallback, result, tt.expected)
+			}
+			if !fitsUTF8MB3(result) {
+				t.Errorf("EncodeForUTF8MB3(%q, %s) = %q, which doesn't fit utf8mb3", tt.input, tt.fallback, result)
+			}
+		})
+	}
+}
+
+func TestEncodeForUTF8MB3Fits(t *testing.T) {
+	inputs := []string{
+		"\U0001F1E6",
+		"\U0001F1E6\U0001F1E6\U0001F1E6",
+		"\U0001F3FD\U0001F3FD",
+		"\U0001F469\u200D\U0001F3FD",
+		"\U000E0067\U000E007F",
+		"\U0001F3F4\U000E0067",
+		"a\U0010FFFF\xff\U0001F604",
+	}
+	// Every code point, in runs of neighbours
+	for start := rune(0); start <= utf8.MaxRune; start += 64 {
+		var b strings.Builder
+		for r := start; r < start+64 && r <= utf8.MaxRune; r++ {
+			if utf8.ValidRune(r) {
+				b.WriteRune(r)
+			}
+		}
+		inputs = append(inputs, b.String())
+	}
+
+	for _, input := range inputs {
+		for _, fallback := range []Format{FormatShortcode, FormatHTML, FormatEmoji} {
+			if result := EncodeForUTF8MB3(input, fallback); !fitsUTF8MB3(result) {
+				t.Errorf("EncodeForUTF8MB3(%q, %s) = %q, which doesn't fit utf8mb3", input, fallback, result)
+			}
+		}
+	}
+}
+
+func TestDecodeFromUTF8MB3(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{"shortcode", "Hi :smile:!", "Hi 😄!"},
+		{"HTML", "Hi &#x1f604;!", "Hi 😄!"},
+		{"HTML sequence", "&#x1f937;&#x200d;&#x2640;&#xfe0f;&#x1f604;", "\U0001F937\u200D\u2640\uFE0F😄"},
+		{"BMP shortcode kept", ":sun: :heart:", ":sun: :heart:"},
+		{"BMP HTML kept", "&#x2600;&#xfe0f; &amp; &#x41;", "&#x2600;&#xfe0f; &amp; &#x41;"},
+		{"unknown emoji", "&#x1fae8;", "\U0001FAE8"},
+		{"non-BMP characters", "&#x1d49c; &#x20000;&#x20001;", "\U0001D49C \U00020000\U00020001"},
+		{"unknown shortcode kept", ":not_an_emoji:", ":not_an_emoji:"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := DecodeFromUTF8MB3(tt.input)
+			if result != tt.expected {
+				t.Errorf("DecodeFromUTF8MB3(%q) = %q, expected %q", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestUTF8MB3RoundTrip(t *testing.T) {
+	for _, fallback := range []Format{FormatShortcode, FormatHTML} {
+		for name, mapping := range emojiMappings {
+			text := "a " + mapping.Emoji + " b"
+			encoded := EncodeForUTF8MB3(text, fallback)
+			if !fitsUTF8MB3(encoded) {
+				t.Errorf("EncodeForUTF8MB3(%s, %s) = %q, which doesn't fit utf8mb3", name, fallback, encoded)
+			}
+			if decoded := DecodeFromUTF8MB3(encoded); decoded != text {
+				t.Errorf("DecodeFromUTF8MB3(EncodeForUTF8MB3(%s, %s)) = %q, expected %q", name, fallback, decoded, text)
+			}
+		}
+
+		// Characters outside the BMP that aren't supported emojis
+		for _, text := range []string{
+			"\U0001D49C \U00020000 \U0001F1E6",
+			"\U00020000\U00020001 CJK",
+			"\U0001F1E6x \U0001F3FD",
+			"\U0001FAE8 and \U0001F600\U0001D49C",
+		} {
+			encoded := EncodeForUTF8MB3(text, fallback)
+			if !fitsUTF8MB3(encoded) {
+				t.Errorf("EncodeForUTF8MB3(%q, %s) = %q, which doesn't fit utf8mb3", text, fallback, encoded)
+			}
+			if decoded := DecodeFromUTF8MB3(encoded); decoded != text {
+				t.Errorf("DecodeFromUTF8MB3(EncodeForUTF8MB3(%q, %s)) = %q, expected %q", text, fallback, decoded, text)
+			}
+		}
+	}
+}
@@ -0,0 +1,251 @@
+package gomoji
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Gender is the gender of a person emoji.
+type Gender string
+
+const (
+	// GenderNeutral is a person without a specified gender (🧑, 🧑‍🚒, 🤷).
+	GenderNeutral Gender = "neutral"
+	// GenderMale is a man (👨, 👨‍🚒, 🤷‍♂️).
+	GenderMale Gender = "male"
+	// GenderFemale is a woman (👩, 👩‍🚒, 🤷‍♀️).
+	GenderFemale Gender = "female"
+)
+
+// Code points of the gender and hair components of person emojis
+const (
+	personBase = '\U0001F9D1'
+	manBase    = '\U0001F468'
+	womanBase  = '\U0001F469'
+	maleSign   = '\u2642'
+	femaleSign = '\u2640'
+	hairFirst  = '\U0001F9B0' // Red hair
+	hairLast   = '\U0001F9B3' // White hair
+)
+
+// variantIndex maps the variant key of every emoji to the names of the
+// emojis sharing it, ordered by gender, hair style and skin tone
+var variantIndex map[string][]string
+
+// personVariant is an emoji split into its gender, skin tone and hair style
+// components and the key shared by all its variants.
+type personVariant struct {
+	key    string
+	gender Gender
+	tone   rune
+	hair   rune
+}
+
+// Variants returns the gender, hair style and skin tone variants of the
+// emoji given in any supported format, including the emoji itself, ordered
+// by gender (neutral, male, female) and then by hair style and skin tone.
+// It returns nil if the input is not a supported emoji.
+//
+// Example:
+//
+//	variants := Variants("👩‍🚒")
+//	// variants[0].Emoji: "🧑‍🚒", variants[1].Emoji: "👨‍🚒", variants[2].Emoji: "👩‍🚒"
+func Variants(input string) []Mapping {
+	name := findEmojiName(input)
+	if name == "" {
+		return nil
+	}
+
+	var results []Mapping
+	for _, variant := range variantIndex[splitVariant(emojiMappings[name].Emoji).key] {
+		results = append(results, mappingOf(variant))
+	}
+	return results
+}
+
+// WithGender returns the variant of the emoji given in any supported format
+// with the given gender, keeping its skin tone and hair style.
+//
+// Returns an error if the input is not supported, the gender is invalid or
+// the emoji has no supported variant with that gender.
+//
+// Example:
+//
+//	info, err := WithGender("🧑‍🚒", GenderFemale)
+//	// info.Emoji: "👩‍🚒"
+func WithGender(input string, gender Gender) (*Mapping, error) {
+	if gender != GenderNeutral && gender != GenderMale && gender != GenderFemale {
+		return nil, fmt.Errorf("invalid gender: %s. Valid genders: %s, %s, %s", gender, GenderNeutral, GenderMale, GenderFemale)
+	}
+
+	name := findEmojiName(input)
+	if name == "" {
+		return nil, newNotFoundError(input)
+	}
+
+	variant := splitVariant(emojiMappings[name].Emoji)
+	variant.gender = gender
+	variantName, exists := lookupEmoji(variant.compose())
+	if !exists {
+		return nil, fmt.Errorf("emoji %s has no %s variant", emojiMappings[name].Shortcode, gender)
+	}
+
+	mapping := mappingOf(variantName)
+	return &mapping, nil
+}
+
+// BaseOf returns the base emoji of the emoji given in any supported format,
+// without its gender, skin tone and hair style: "👩🏽‍🦰" becomes "🧑".
+//
+// Returns an error if the input is not supported or its base emoji is not.
+func BaseOf(input string) (*Mapping, error) {
+	name := findEmojiName(input)
+	if name == "" {
+		return nil, newNotFoundError(input)
+	}
+
+	key := splitVariant(emojiMappings[name].Emoji).key
+	baseName, exists := lookupEmoji(key)
+	if !exists {
+		return nil, fmt.Errorf("base emoji %q of %s is not supported", key, emojiMappings[name].Shortcode)
+	}
+
+	mapping := mappingOf(baseName)
+	return &mapping, nil
+}
+
+// splitVariant splits emoji into its components. The gender is given either
+// by the first character (🧑, 👨, 👩) or by a trailing gender sign (♂, ♀).
+// The key is the gender neutral emoji without skin tone and hair style.
+func splitVariant(emoji string) personVariant {
+	variant := personVariant{gender: GenderNeutral}
+
+	var elements [][]rune
+	for _, part := range strings.Split(stripVariationSelectors(emoji), zeroWidthJoiner) {
+		var element []rune
+		for _, r := range part {
+			if isEmojiModifier(r) {
+				variant.tone = r
+				continue
+			}
+			element = append(element, r)
+		}
+		if len(element) == 1 && element[0] >= hairFirst && element[0] <= hairLast {
+			variant.hair = element[0]
+			continue
+		}
+		elements = append(elements, element)
+	}
+	if len(elements) == 0 {
+		variant.key = stripVariationSelectors(emoji)
+		return variant
+	}
+
+	if last := elements[len(elements)-1]; len(elements) > 1 && len(last) == 1 {
+		switch last[0] {
+		case maleSign:
+			variant.gender = GenderMale
+			elements = elements[:len(elements)-1]
+		case femaleSign:
+			variant.gender = GenderFemale
+			elements = elements[:len(elements)-1]
+		}
+	}
+	if first := elements[0]; len(first) > 0 {
+		switch first[0] {
+		case manBase:
+			variant.gender = GenderMale
+			first[0] = personBase
+		case womanBase:
+			variant.gender = GenderFemale
+			first[0] = personBase
+		}
+	}
+
+	parts := make([]string, len(elements))
+	for i, element := range elements {
+		parts[i] = string(element)
+	}
+	variant.key = strings.Join(parts, zeroWidthJoiner)
+	return variant
+}
+
+// compose builds the emoji of the variant, without variation selectors.
+func (v personVariant) compose() string {
+	runes := []rune(v.key)
+	signed := runes[0] != personBase
+	if !signed {
+		switch v.gender {
+		case GenderMale:
+			runes[0] = manBase
+		case GenderFemale:
+			runes[0] = womanBase
+		}
+	}
+
+	var b strings.Builder
+	b.WriteRune(runes[0])
+	if v.tone != 0 {
+		b.WriteRune(v.tone)
+	}
+	if v.hair != 0 {
+		b.WriteString(zeroWidthJoiner)
+		b.WriteRune(v.hair)
+	}
+	b.WriteString(string(runes[1:]))
+	if signed && v.gender == GenderMale {
+		b.WriteString(zeroWidthJoiner + string(maleSign))
+	}
+	if signed && v.gender == GenderFemale {
+		b.WriteString(zeroWidthJoiner + string(femaleSign))
+	}
+	return b.String()
+}
+
+// less reports whether v is ordered before other among variants of the same
+// emoji: by gender, then hair style and then skin tone.
+func (v personVariant) less(other personVariant) bool {
+	if v.gender != other.gender {
+		return genderRank(v.gender) < genderRank(other.gender)
+	}
+	if v.hair != other.hair {
+		return v.hair < other.hair
+	}
+	return v.tone < other.tone
+}
+
+// genderRank returns the position of gender in variant lists.
+func genderRank(gender Gender) int {
+	switch gender {
+	case GenderMale:
+		return 1
+	case GenderFemale:
+		return 2
+	default:
+		return 0
+	}
+}
+
+// buildVariantIndex groups the emojis sharing a variant key for Variants.
+func buildVariantIndex() {
+	variantIndex = make(map[string][]string)
+	variants := make(map[string]personVariant, len(emojiMappings))
+	for name, mapping := range emojiMappings {
+		variant := splitVariant(mapping.Emoji)
+		variants[name] = variant
+		variantIndex[variant.key] = append(variantIndex[variant.key], name)
+	}
+	for _, names := range variantIndex {
+		sort.Slice(names, func(i, j int) bool {
+			a, b := variants[names[i]], variants[names[j]]
+			if a.less(b) {
+				return true
+			}
+			if b.less(a) {
+				return false
+			}
+			return names[i] < names[j]
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package gomoji
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestVariants(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected []string
+	}{
+		{"profession", "\U0001F469\u200D\U0001F692", []string{"firefighter", "man_firefighter", "woman_firefighter"}},
+		{"profession shortcode", ":health_worker:", []string{"health_worker", "man_health_worker", "woman_health_worker"}},
+		{"gender sign", "\U0001F937", []string{"person_shrugging", "man_shrugging", "woman_shrugging"}},
+		{"gender sign without selector", "\U0001F926\u200D\u2640", []string{"person_facepalming", "man_facepalming", "woman_facepalming"}},
+		{"hair styles", "woman_bald", []string{
+			"person", "person_red_hair", "person_curly_hair", "person_bald", "person_white_hair",
+			"man", "man_red_hair", "man_curly_hair", "man_bald", "man_white_hair",
+			"woman", "woman_red_hair", "woman_curly_hair", "woman_bald", "woman_white_hair",
+		}},
+		{"teacher", "woman_teacher", []string{
+			"teacher", "teacher_medium_skin_tone",
+			"man_teacher", "man_teacher_medium_skin_tone",
+			"woman_teacher_default_skin_tone", "woman_teacher",
+		}},
+		{"no variants", "😄", []string{"smile"}},
+		{"unsupported", "not an emoji", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results := Variants(tt.input)
+			if len(results) != len(tt.expected) {
+				t.Fatalf("Variants(%s) returned %d results, expected %d", tt.input, len(results), len(tt.expected))
+			}
+			for i, name := range tt.expected {
+				if results[i].Shortcode != ":"+name+":" {
+					t.Errorf("Variants(%s)[%d] = %s, expected :%s:", tt.input, i, results[i].Shortcode, name)
+				}
+			}
+		})
+	}
+}
+
+func TestWithGender(t *testing.T) {
+	tests := []struct {
+		input    string
+		gender   Gender
+		expected string
+	}{
+		{"\U0001F9D1\u200D\U0001F692", GenderFemale, "\U0001F469\u200D\U0001F692"},
+		{"\U0001F469\u200D\U0001F692", GenderMale, "\U0001F468\u200D\U0001F692"},
+		{"\U0001F468\u200D\U0001F692", GenderNeutral, "\U0001F9D1\u200D\U0001F692"},
+		{":man_health_worker:", GenderFemale, "\U0001F469\u200D\u2695\uFE0F"},
+		{"\U0001F937", GenderFemale, "\U0001F937\u200D\u2640\uFE0F"},
+		{"\U0001F937\u200D\u2640\uFE0F", GenderMale, "\U0001F937\u200D\u2642\uFE0F"},
+		{"\U0001F937\u200D\u2642\uFE0F", GenderNeutral, "\U0001F937"},
+		{"person_red_hair", GenderFemale, "\U0001F469\u200D\U0001F9B0"},
+		{"woman_teacher", GenderMale, "\U0001F468\U0001F3FD\u200D\U0001F3EB"},
+		{"woman_teacher", GenderNeutral, "\U0001F9D1\U0001F3FD\u200D\U0001F3EB"},
+		{"\U0001F469", GenderFemale, "\U0001F469"},
+	}
+
+	for _, tt := range tests {
+		info, err := WithGender(tt.input, tt.gender)
+		if err != nil {
+			t.Fatalf("WithGender(%q, %s) returned error: %v", tt.input, tt.gender, err)
+		}
+		if info.Emoji != tt.expected {
+			t.Errorf("WithGender(%q, %s) = %q, expected %q", tt.input, tt.gender, info.Emoji, tt.expected)
+		}
+	}
+
+	if _, err := WithGender("😄", GenderFemale); err == nil {
+		t.Error("WithGender(😄, female) expected error, got nil")
+	}
+	if _, err := WithGender("person", Gender("other")); err == nil {
+		t.Error("WithGender with an invalid gender expected error, got nil")
+	}
+	var notFound *NotFoundError
+	if _, err := WithGender("not an emoji", GenderFemale); !errors.As(err, &notFound) {
+		t.Errorf("WithGender of an unsupported emoji returned %v, expected a *NotFoundError", err)
+	}
+}
+
+func TestBaseOf(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"\U0001F469\u200D\U0001F692", "firefighter"},
+		{"\U0001F468\u200D\U0001F9B0", "person"},
+		{"woman_white_hair", "person"},
+		{"\U0001F937\u200D\u2640\uFE0F", "person_shrugging"},
+		{":man_health_worker:", "health_worker"},
+		{"\U0001F9D1", "person"},
+		{"😄", "smile"},
+		{"#\uFE0F\u20E3", "hash"},
+		{":flag_gb_eng:", "flag_gb_eng"},
+		{"woman_teacher", "teacher"},
+	}
+
+	for _, tt := range tests {
+		info, err := BaseOf(tt.input)
+		if err != nil {
+			t.Fatalf("BaseOf(%q) returned error: %v", tt.input, err)
+		}
+		if info.Shortcode != ":"+tt.expected+":" {
+			t.Errorf("BaseOf(%q) = %s, expected :%s:", tt.input, info.Shortcode, tt.expected)
+		}
+	}
+}
+
+func TestSplitVariantRoundTrip(t *testing.T) {
+	for name, mapping := range emojiMappings {
+		composed := splitVariant(mapping.Emoji).compose()
+		if found, _ := lookupEmoji(composed); found != name {
+			t.Errorf("splitVariant(%s).compose() = %q, which resolves to %q", name, composed, found)
+		}
+	}
+}
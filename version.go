@@ -0,0 +1,34 @@
+package gomoji
+
+import "strings"
+
+// FilterByMaxVersion rewrites every supported emoji in text introduced after
+// the Emoji version maxVersion to the fallback format, such as
+// FormatShortcode or FormatDescription, so that clients supporting only
+// older emojis don't display them as missing glyphs. Older emojis, emojis
+// missing from the table and emojis in other formats are kept as they are,
+// as are emojis that cannot be transformed to fallback.
+//
+// Example:
+//
+//	text := FilterByMaxVersion("Nice 🤌 😄", 12.0, FormatShortcode)
+//	// text: "Nice :pinched_fingers: 😄"
+func FilterByMaxVersion(text string, maxVersion float64, fallback Format) string {
+	var b strings.Builder
+	last := 0
+	for _, cluster := range findEmojiClusters(text) {
+		name, exists := lookupEmoji(text[cluster[0]:cluster[1]])
+		if !exists || emojiMappings[name].EmojiVersion <= maxVersion {
+			continue
+		}
+		transformed, err := Transform(name, fallback)
+		if err != nil {
+			continue
+		}
+		b.WriteString(text[last:cluster[0]])
+		b.WriteString(transformed)
+		last = cluster[1]
+	}
+	b.WriteString(text[last:])
+	return b.String()
+}
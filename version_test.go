@@ -0,0 +1,65 @@
+package gomoji
+
+import "testing"
+
+func TestEmojiVersion(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected float64
+	}{
+		{"smile", 0.6},
+		{"grinning", 1.0},
+		{"black_heart", 3.0},
+		{"woman_teacher", 4.0},
+		{"flag_gb_eng", 5.0},
+		{"chair", 12.0},
+		{"pinched_fingers", 13.0},
+		{"flag_us", 0.6},
+		{"flag_kr", 0.6},
+		{"flag_pe", 2.0},
+		{"flag_un", 4.0},
+	}
+
+	for _, tt := range tests {
+		info, err := GetEmojiInfo(tt.input)
+		if err != nil {
+			t.Fatalf("GetEmojiInfo(%s) returned error: %v", tt.input, err)
+		}
+		if info.EmojiVersion != tt.expected {
+			t.Errorf("GetEmojiInfo(%s).EmojiVersion = %.1f, expected %.1f", tt.input, info.EmojiVersion, tt.expected)
+		}
+	}
+
+	for name, mapping := range emojiMappings {
+		if mapping.EmojiVersion == 0 {
+			t.Errorf("%s has no emoji version", name)
+		}
+	}
+}
+
+func TestFilterByMaxVersion(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      string
+		maxVersion float64
+		fallback   Format
+		expected   string
+	}{
+		{"newer to shortcode", "Nice 🤌 😄", 12.0, FormatShortcode, "Nice :pinched_fingers: 😄"},
+		{"newer to description", "Sit 🪑", 11.0, FormatDescription, "Sit chair"},
+		{"all supported", "Nice 🤌 😄", 13.0, FormatShortcode, "Nice 🤌 😄"},
+		{"tag sequence", "Go \U0001F3F4\U000E0067\U000E0062\U000E0065\U000E006E\U000E0067\U000E007F!", 4.0, FormatShortcode, "Go :flag_gb_eng:!"},
+		{"other formats kept", ":pinched_fingers: &#x1f90c;", 1.0, FormatDescription, ":pinched_fingers: &#x1f90c;"},
+		{"unknown emoji kept", "\U0001FAE8", 1.0, FormatShortcode, "\U0001FAE8"},
+		{"invalid fallback", "🤌", 1.0, Format("invalid"), "🤌"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := FilterByMaxVersion(tt.input, tt.maxVersion, tt.fallback)
+			if result != tt.expected {
+				t.Errorf("FilterByMaxVersion(%q, %.1f, %s) = %q, expected %q", tt.input, tt.maxVersion, tt.fallback, result, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package gomoji
+
+import (
+	"unicode"
+
+	"golang.org/x/text/width"
+)
+
+// Width returns the number of terminal columns text occupies. Emoji
+// sequences, including ZWJ sequences rendered as one glyph, take two
+// columns, and characters that are text by default take one unless followed
+// by the emoji presentation selector (U+FE0F). Shortcodes, HTML references
+// and escapes are measured as the text they are written with.
+//
+// Example:
+//
+//	Width("ok 👍")   // 5
+//	Width("❤ vs ❤️") // 7
+func Width(text string) int {
+	total := 0
+	for _, unit := range splitUnits(text) {
+		total += unitWidth(text[unit[0]:unit[1]])
+	}
+	return total
+}
+
+// Truncate returns the longest prefix of text that fits in cols terminal
+// columns, as measured by Width. Emojis, HTML references, escapes and
+// shortcodes are never cut in the middle: they are kept or dropped whole.
+//
+// Example:
+//
+//	Truncate("Hi 👩‍👩‍👧!", 4) // "Hi "
+//	Truncate("Hi 👩‍👩‍👧!", 5) // "Hi 👩‍👩‍👧"
+func Truncate(text string, cols int) string {
+	return truncateUnits(text, cols, "", Width)
+}
+
+// unitWidth returns the number of columns of a unit returned by splitUnits.
+func unitWidth(unit string) int {
+	if emojiClusterLength(unit) == len(unit) {
+		return 2
+	}
+	total := 0
+	for _, r := range unit {
+		total += runeWidth(r)
+	}
+	return total
+}
+
+// runeWidth returns the number of columns of a single character: none for
+// control characters, combining marks and format characters, two for East
+// Asian wide and fullwidth characters and one otherwise.
+func runeWidth(r rune) int {
+	switch {
+	case unicode.IsControl(r), unicode.In(r, unicode.Mn, unicode.Me, unicode.Cf, unicode.Variation_Selector):
+		return 0
+	}
+	switch width.LookupRune(r).Kind() {
+	case width.EastAsianWide, width.EastAsianFullwidth:
+		return 2
+	default:
+		return 1
+	}
+}
@@ -0,0 +1,73 @@
+package gomoji
+
+import "testing"
+
+func TestWidth(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected int
+	}{
+		{"plain text", "hello", 5},
+		{"empty", "", 0},
+		{"emoji", "ok 👍", 5},
+		{"text-default pictograph", "\u2764", 1},
+		{"emoji presentation selector", "\u2764\uFE0F", 2},
+		{"text presentation selector", "\u2764\uFE0E", 1},
+		{"skin tone", "👍\U0001F3FD", 2},
+		{"ZWJ sequence", "\U0001F469\u200D\U0001F469\u200D\U0001F467", 2},
+		{"flag", "\U0001F1F5\U0001F1EA", 2},
+		{"tag sequence", "\U0001F3F4\U000E0067\U000E0062\U000E0065\U000E006E\U000E0067\U000E007F", 2},
+		{"keycap", "#\uFE0F\u20E3", 2},
+		{"combining mark", "e\u0301", 1},
+		{"wide characters", "日本", 4},
+		{"shortcode", ":smile:", 7},
+		{"HTML reference", "&#x1f604;", 9},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := Width(tt.input); result != tt.expected {
+				t.Errorf("Width(%q) = %d, expected %d", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	const family = "\U0001F469\u200D\U0001F469\u200D\U0001F467"
+
+	tests := []struct {
+		name     string
+		input    string
+		cols     int
+		expected string
+	}{
+		{"fits", "hello", 10, "hello"},
+		{"plain text", "hello", 3, "hel"},
+		{"zero columns", "hello", 0, ""},
+		{"emoji dropped whole", "Hi " + family + "!", 4, "Hi "},
+		{"emoji kept whole", "Hi " + family + "!", 5, "Hi " + family},
+		{"selector kept with pictograph", "\u2764\uFE0F!", 2, "\u2764\uFE0F"},
+		{"wide character", "日本", 3, "日"},
+		{"combining mark kept", "e\u0301e", 1, "e\u0301"},
+		{"shortcode dropped whole", "Hi :smile:", 8, "Hi "},
+		{"shortcode kept whole", "Hi :smile:", 10, "Hi :smile:"},
+		{"unknown shortcode cut", "Hi :nope:", 5, "Hi :n"},
+		{"HTML reference dropped whole", "Hi &#x1f604;", 8, "Hi "},
+		{"HTML sequence dropped whole", "Hi &#x2764;&#xfe0f;&#x1f604;", 19, "Hi &#x2764;&#xfe0f;"},
+		{"escape dropped whole", `Hi \U0001F604`, 12, "Hi "},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := Truncate(tt.input, tt.cols)
+			if result != tt.expected {
+				t.Errorf("Truncate(%q, %d) = %q, expected %q", tt.input, tt.cols, result, tt.expected)
+			}
+			if Width(result) > tt.cols {
+				t.Errorf("Truncate(%q, %d) = %q, which is %d columns wide", tt.input, tt.cols, result, Width(result))
+			}
+		})
+	}
+}